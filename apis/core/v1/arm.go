@@ -0,0 +1,110 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ARMIDIndexKey is the field index every kind registered with the controllers has, which holds the ARM ID of each
+// object in lower case, as ARM IDs are case insensitive
+const ARMIDIndexKey = "status.id"
+
+type (
+	// ARMReferenceResolver resolves references to other objects into the ARM resource IDs of those objects
+	ARMReferenceResolver interface {
+		// ResolveARMID returns the ARM resource ID of the referenced object of the given group and kind. If the
+		// referenced object does not exist yet, or has not been assigned an ID, an empty string is returned.
+		ResolveARMID(ctx context.Context, ref KnownTypeReference, group, kind string) (string, error)
+	}
+
+	// ARMIdentified is implemented by objects which record the ID of their ARM resource once it has been applied
+	ARMIdentified interface {
+		// GetARMID returns the ID of the ARM resource, which is empty until it has been applied
+		GetARMID() string
+	}
+
+	// ARMActionFunc invokes an action of the ARM resource of an object, such as listKeys, and returns the response
+	ARMActionFunc func(ctx context.Context, action string) (json.RawMessage, error)
+
+	// SecretsExporter provides secret values of a provisioned resource which are only available through its actions,
+	// such as the access keys of a storage account. The values are written to the outputs Secret of the object
+	// along with its outputs.
+	SecretsExporter interface {
+		ExportSecrets(ctx context.Context, invoke ARMActionFunc) (map[string]string, error)
+	}
+
+	// ARMIDReference is the ARM representation of a reference to another resource
+	ARMIDReference struct {
+		ID string `json:"id,omitempty"`
+	}
+
+	// UnresolvedReferenceError is returned when a reference can not be resolved into an ARM ID because the referenced
+	// object does not exist or has not been provisioned yet
+	UnresolvedReferenceError struct {
+		Kind string
+		Name string
+	}
+)
+
+// ResolveARMIDReference resolves ref, a reference to an object of the given group and kind, into an ARMIDReference.
+// A nil ARMIDReference is returned if the reference is not set. An UnresolvedReferenceError is returned if the
+// referenced object does not exist or does not have an ID yet, so that the referencing object is applied once it has.
+func ResolveARMIDReference(ctx context.Context, resolver ARMReferenceResolver, ref *KnownTypeReference, group, kind string) (*ARMIDReference, error) {
+	if ref == nil || ref.Name == "" {
+		return nil, nil
+	}
+
+	id, err := resolver.ResolveARMID(ctx, *ref, group, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, &UnresolvedReferenceError{
+			Kind: kind,
+			Name: ref.Name,
+		}
+	}
+
+	return &ARMIDReference{ID: id}, nil
+}
+
+// ResolveARMIDReferences resolves refs, references to objects of the given group and kind, into ARMIDReferences.
+// References which are not set are skipped. An UnresolvedReferenceError is returned for the first referenced object
+// which does not exist or does not have an ID yet.
+func ResolveARMIDReferences(ctx context.Context, resolver ARMReferenceResolver, refs []KnownTypeReference, group, kind string) ([]ARMIDReference, error) {
+	var idRefs []ARMIDReference
+	for i := range refs {
+		idRef, err := ResolveARMIDReference(ctx, resolver, &refs[i], group, kind)
+		if err != nil {
+			return nil, err
+		}
+
+		if idRef != nil {
+			idRefs = append(idRefs, *idRef)
+		}
+	}
+
+	return idRefs, nil
+}
+
+func (ure *UnresolvedReferenceError) Error() string {
+	return fmt.Sprintf("%s %q has not been provisioned yet", ure.Kind, ure.Name)
+}
+
+func (ure *UnresolvedReferenceError) Is(target error) bool {
+	_, ok := target.(*UnresolvedReferenceError)
+	return ok
+}
+
+// IsUnresolvedReference returns true if the error was caused by a reference which could not be resolved into an ARM ID
+func IsUnresolvedReference(err error) bool {
+	return errors.Is(err, &UnresolvedReferenceError{})
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type (
+	// ConditionType is the type of a condition, eg. Conflict
+	ConditionType string
+
+	// Condition describes an aspect of the state of an object which the provisioning state alone does not capture
+	// +kubebuilder:object:generate=true
+	Condition struct {
+		// Type of the condition
+		// +kubebuilder:validation:Required
+		Type ConditionType `json:"type"`
+
+		// Status of the condition, one of True, False or Unknown
+		// +kubebuilder:validation:Required
+		Status corev1.ConditionStatus `json:"status"`
+
+		// Reason is a short, machine readable reason for the last transition of the condition
+		// +optional
+		Reason string `json:"reason,omitempty"`
+
+		// Message is a human readable description of the last transition of the condition
+		// +optional
+		Message string `json:"message,omitempty"`
+
+		// LastTransitionTime is the last time the condition changed status
+		// +optional
+		LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	}
+
+	// Conditions is the set of conditions of an object, keyed by type
+	// +kubebuilder:object:generate=true
+	Conditions []Condition
+
+	// Conditioned provides access to the conditions within the status of an object
+	Conditioned interface {
+		GetConditions() Conditions
+		SetConditions(Conditions)
+	}
+)
+
+const (
+	// ConflictCondition is True when the Azure resource was changed outside of the operator since it was last read,
+	// so the operator has stopped rather than overwrite those changes
+	ConflictCondition ConditionType = "Conflict"
+)
+
+// Get returns the condition of the given type, or nil if the condition is not set
+func (c Conditions) Get(conditionType ConditionType) *Condition {
+	for i := range c {
+		if c[i].Type == conditionType {
+			return &c[i]
+		}
+	}
+	return nil
+}
+
+// Set adds or replaces the condition of the same type. The last transition time is only updated when the status of
+// the condition changes.
+func (c Conditions) Set(condition Condition) Conditions {
+	if existing := c.Get(condition.Type); existing != nil {
+		if existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		} else if condition.LastTransitionTime.IsZero() {
+			condition.LastTransitionTime = metav1.Now()
+		}
+		*existing = condition
+		return c
+	}
+
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = metav1.Now()
+	}
+	return append(c, condition)
+}
+
+// Remove returns the conditions without the condition of the given type
+func (c Conditions) Remove(conditionType ConditionType) Conditions {
+	var conditions Conditions
+	for _, condition := range c {
+		if condition.Type != conditionType {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+// IsTrue returns true if the condition of the given type is set and has a status of True
+func (c Conditions) IsTrue(conditionType ConditionType) bool {
+	condition := c.Get(conditionType)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
@@ -0,0 +1,212 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConversionDataAnnotation is an annotation key which holds the fields of the hub an object was converted from which
+// its version is unable to represent, so they are not lost when it is converted back to the hub
+const ConversionDataAnnotation = "conversion-data.infra.azure.com"
+
+// StoreConversionData records the fields of the spec and status of the hub which do not survive conversion to the
+// object and back in an annotation on the object converted from it. It should be called after converting the hub to
+// the object. Nothing is recorded if the object represents the hub in full.
+func StoreConversionData(hub conversion.Hub, dst conversion.Convertible) error {
+	dstObj, ok := dst.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("expected %T to be a metav1.Object", dst)
+	}
+
+	roundTrip := reflect.New(reflect.TypeOf(hub).Elem()).Interface().(conversion.Hub)
+	if err := dst.ConvertTo(roundTrip); err != nil {
+		return fmt.Errorf("unable to convert %T back to the hub with: %w", dst, err)
+	}
+
+	before, err := specAndStatus(hub)
+	if err != nil {
+		return err
+	}
+
+	after, err := specAndStatus(roundTrip)
+	if err != nil {
+		return err
+	}
+
+	lost := lostFields(before, after)
+	if len(lost) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(lost)
+	if err != nil {
+		return fmt.Errorf("unable to marshal conversion data with: %w", err)
+	}
+
+	// conversion shares the annotations of the hub, so they are copied rather than modified
+	annotations := make(map[string]string, len(dstObj.GetAnnotations())+1)
+	for k, v := range dstObj.GetAnnotations() {
+		annotations[k] = v
+	}
+	annotations[ConversionDataAnnotation] = string(data)
+	dstObj.SetAnnotations(annotations)
+	return nil
+}
+
+// specAndStatus returns the spec and status of the object as JSON values; the metadata is converted as is, so only the
+// spec and status may lose fields
+func specAndStatus(obj interface{}) (map[string]interface{}, error) {
+	value := reflect.Indirect(reflect.ValueOf(obj))
+	result := make(map[string]interface{})
+	for _, name := range []string{"Spec", "Status"} {
+		field := value.FieldByName(name)
+		if !field.IsValid() {
+			continue
+		}
+
+		data, err := json.Marshal(field.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal the %s of %T with: %w", name, obj, err)
+		}
+
+		var fields interface{}
+		if err := decodeJSON(data, &fields); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal the %s of %T with: %w", name, obj, err)
+		}
+		result[strings.ToLower(name)] = fields
+	}
+	return result, nil
+}
+
+// decodeJSON unmarshals the data keeping numbers as written, so 64 bit integers are compared and restored exactly
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// lostFields returns the fields of before which differ in after. A field which is only set in after is returned as
+// null, so it is removed again when the fields are restored.
+func lostFields(before, after map[string]interface{}) map[string]interface{} {
+	lost := make(map[string]interface{})
+	for key, value := range before {
+		afterValue, ok := after[key]
+		if !ok {
+			lost[key] = value
+			continue
+		}
+
+		valueMap, isMap := value.(map[string]interface{})
+		afterMap, afterIsMap := afterValue.(map[string]interface{})
+		if isMap && afterIsMap {
+			if nested := lostFields(valueMap, afterMap); len(nested) > 0 {
+				lost[key] = nested
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(value, afterValue) {
+			lost[key] = value
+		}
+	}
+
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			lost[key] = nil
+		}
+	}
+
+	return lost
+}
+
+// RestoreConversionData sets the fields of the hub recorded in the annotation on the object being converted to it. It
+// should be called before converting the object to the hub, so the fields the version of the object is able to
+// represent take precedence over the restored values, and after setting any defaults for fields which have no
+// equivalent in the version of the object, such as the API version of the hub, so the restored values take precedence
+// over the defaults.
+func RestoreConversionData(src metav1.Object, hub interface{}) error {
+	data, ok := src.GetAnnotations()[ConversionDataAnnotation]
+	if !ok {
+		return nil
+	}
+
+	var lost map[string]interface{}
+	if err := decodeJSON([]byte(data), &lost); err != nil {
+		return fmt.Errorf("unable to unmarshal conversion data with: %w", err)
+	}
+
+	current, err := specAndStatus(hub)
+	if err != nil {
+		return err
+	}
+
+	merged, err := json.Marshal(mergeFields(current, lost))
+	if err != nil {
+		return fmt.Errorf("unable to marshal restored fields with: %w", err)
+	}
+
+	// the spec and status are cleared so fields recorded as null are unset
+	value := reflect.Indirect(reflect.ValueOf(hub))
+	for _, name := range []string{"Spec", "Status"} {
+		if field := value.FieldByName(name); field.IsValid() {
+			field.Set(reflect.Zero(field.Type()))
+		}
+	}
+
+	if err := json.Unmarshal(merged, hub); err != nil {
+		return fmt.Errorf("unable to unmarshal restored fields with: %w", err)
+	}
+
+	return nil
+}
+
+// mergeFields sets the fields of dst to those of src, removing those which are null in src
+func mergeFields(dst, src map[string]interface{}) map[string]interface{} {
+	for key, value := range src {
+		if value == nil {
+			delete(dst, key)
+			continue
+		}
+
+		valueMap, isMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if isMap && dstIsMap {
+			dst[key] = mergeFields(dstMap, valueMap)
+			continue
+		}
+
+		dst[key] = value
+	}
+	return dst
+}
+
+// RemoveConversionData removes the ConversionDataAnnotation from the object, as the hub holds all of its fields
+func RemoveConversionData(obj metav1.Object) {
+	if _, ok := obj.GetAnnotations()[ConversionDataAnnotation]; !ok {
+		return
+	}
+
+	var annotations map[string]string
+	for k, v := range obj.GetAnnotations() {
+		if k == ConversionDataAnnotation {
+			continue
+		}
+
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[k] = v
+	}
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	defaultsLog = logf.Log.WithName("defaults")
+
+	// the webhooks are built from the decoded object alone, so what they need from the cluster is configured once
+	// when the manager starts
+	webhookMu            sync.RWMutex
+	webhookReader        client.Reader
+	defaultTagsConfigMap types.NamespacedName
+
+	knownTypeReferenceType = reflect.TypeOf(KnownTypeReference{})
+
+	// resourceGroupGVK is read unstructured, as the resources group depends upon this one
+	resourceGroupGVK = schema.GroupVersionKind{Group: "microsoft.resources.infra.azure.com", Version: "v1", Kind: "ResourceGroup"}
+)
+
+// ConfigureWebhooks sets the reader the webhooks use to look up related objects. If tagsConfigMap has a name, the data
+// of that ConfigMap are the organization wide tags added to every resource which supports tags.
+func ConfigureWebhooks(reader client.Reader, tagsConfigMap types.NamespacedName) {
+	webhookMu.Lock()
+	defer webhookMu.Unlock()
+
+	webhookReader = reader
+	defaultTagsConfigMap = tagsConfigMap
+}
+
+// WebhookReader returns the reader configured for the webhooks, or nil if they have not been configured
+func WebhookReader() client.Reader {
+	webhookMu.RLock()
+	defer webhookMu.RUnlock()
+
+	return webhookReader
+}
+
+// DefaultTags returns the tags with each organization wide default tag added, unless the key is already set
+func DefaultTags(tags map[string]string) map[string]string {
+	webhookMu.RLock()
+	reader, key := webhookReader, defaultTagsConfigMap
+	webhookMu.RUnlock()
+
+	if reader == nil || key.Name == "" {
+		return tags
+	}
+
+	var cm corev1.ConfigMap
+	if err := reader.Get(context.Background(), key, &cm); err != nil {
+		defaultsLog.Error(err, "unable to read default tags", "configMap", key)
+		return tags
+	}
+
+	if len(cm.Data) == 0 {
+		return tags
+	}
+
+	if tags == nil {
+		tags = make(map[string]string, len(cm.Data))
+	}
+
+	for k, v := range cm.Data {
+		if _, ok := tags[k]; !ok {
+			tags[k] = v
+		}
+	}
+
+	return tags
+}
+
+// DefaultLocation returns the location of the referenced resource group if location is not already set
+func DefaultLocation(location string, groupRef *KnownTypeReference, namespace string) string {
+	reader := WebhookReader()
+	if location != "" || groupRef == nil || groupRef.Name == "" || reader == nil {
+		return location
+	}
+
+	key := client.ObjectKey{
+		Name:      groupRef.Name,
+		Namespace: groupRef.Namespace,
+	}
+	if key.Namespace == "" {
+		key.Namespace = namespace
+	}
+
+	rg := new(unstructured.Unstructured)
+	rg.SetGroupVersionKind(resourceGroupGVK)
+	if err := reader.Get(context.Background(), key, rg); err != nil {
+		defaultsLog.Error(err, "unable to read resource group to default location", "resourceGroup", key)
+		return location
+	}
+
+	rgLocation, _, err := unstructured.NestedString(rg.Object, "spec", "location")
+	if err != nil {
+		defaultsLog.Error(err, "unable to read location of resource group", "resourceGroup", key)
+		return location
+	}
+
+	return rgLocation
+}
+
+// DefaultReferenceNamespaces sets the namespace of each KnownTypeReference within the Spec of obj which does not
+// specify one to the namespace of obj
+func DefaultReferenceNamespaces(obj metav1.Object) {
+	if obj.GetNamespace() == "" {
+		return
+	}
+
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if spec := v.FieldByName("Spec"); spec.IsValid() {
+		defaultReferenceNamespaces(spec, obj.GetNamespace())
+	}
+}
+
+func defaultReferenceNamespaces(v reflect.Value, namespace string) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			defaultReferenceNamespaces(v.Elem(), namespace)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			defaultReferenceNamespaces(v.Index(i), namespace)
+		}
+	case reflect.Struct:
+		if v.Type() == knownTypeReferenceType {
+			if ref := v.Addr().Interface().(*KnownTypeReference); ref.Name != "" && ref.Namespace == "" {
+				ref.Namespace = namespace
+			}
+			return
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				defaultReferenceNamespaces(v.Field(i), namespace)
+			}
+		}
+	}
+}
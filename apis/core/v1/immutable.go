@@ -0,0 +1,205 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// ImmutableTag is the struct tag which marks a spec field as unable to change once the resource has been created
+	// in Azure, eg. `json:"location" immutable:"true"`
+	ImmutableTag = "immutable"
+
+	// RecreateAnnotation is the key which allows the immutable fields of an object to be changed. When the value is
+	// "true", the Azure resource will be deleted and created again with the new spec.
+	RecreateAnnotation = "x-recreate-on-immutable-change"
+)
+
+// ValidateImmutableFields compares the fields of the Spec tagged as immutable and the parent of the old and new
+// objects, returning an error for each which has changed. Changes are allowed if the new object has opted in to being
+// recreated via the RecreateAnnotation, or if the resource has not been created in Azure yet, such as after a failed
+// first deploy.
+func ValidateImmutableFields(old, new MetaObject) field.ErrorList {
+	if IsRecreateAllowed(new) {
+		return nil
+	}
+
+	if identified, ok := old.(ARMIdentified); ok && identified.GetARMID() == "" {
+		return nil
+	}
+
+	oldFields := immutableFields(old)
+	newFields := immutableFields(new)
+	paths := make(map[string]bool, len(newFields))
+	for path := range oldFields {
+		paths[path] = true
+	}
+	for path := range newFields {
+		paths[path] = true
+	}
+
+	var allErrs field.ErrorList
+	for _, path := range sortedKeys(paths) {
+		if !reflect.DeepEqual(oldFields[path], newFields[path]) {
+			allErrs = append(allErrs, field.Forbidden(immutablePath(path), immutableMessage()))
+		}
+	}
+
+	return append(allErrs, validateParentUnchanged(old, new)...)
+}
+
+// ImmutableFieldsSignature returns a hash of the values of the immutable fields of the object, so a change to them can
+// be detected after the object has been updated
+func ImmutableFieldsSignature(obj MetaObject) (string, error) {
+	bits, err := json.Marshal(immutableFields(obj))
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal immutable fields with: %w", err)
+	}
+
+	hash := sha256.Sum256(bits)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// IsRecreateAllowed returns true if the object has opted in to being deleted and recreated in Azure when an immutable
+// field changes
+func IsRecreateAllowed(obj metav1.Object) bool {
+	return strings.ToLower(obj.GetAnnotations()[RecreateAnnotation]) == "true"
+}
+
+// validateParentUnchanged ensures a child resource stays with the owner within its own API group which it was first
+// given, as the parent is implied by the name of the resource in Azure
+func validateParentUnchanged(old, new MetaObject) field.ErrorList {
+	group := new.GetObjectKind().GroupVersionKind().Group
+	oldParents := parentOwnerReferences(old.GetOwnerReferences(), group)
+
+	var allErrs field.ErrorList
+	refsPath := field.NewPath("metadata", "ownerReferences")
+	for i, ref := range new.GetOwnerReferences() {
+		oldParent, ok := oldParents[ref.Kind]
+		if !ok || ownerGroup(ref) != group {
+			continue
+		}
+
+		if oldParent.Name != ref.Name || oldParent.UID != ref.UID {
+			msg := fmt.Sprintf("the parent %s %q can not be changed; %s", ref.Kind, oldParent.Name, immutableMessage())
+			allErrs = append(allErrs, field.Forbidden(refsPath.Index(i), msg))
+		}
+	}
+
+	return allErrs
+}
+
+func parentOwnerReferences(refs []metav1.OwnerReference, group string) map[string]metav1.OwnerReference {
+	parents := make(map[string]metav1.OwnerReference)
+	for _, ref := range refs {
+		if ownerGroup(ref) == group {
+			parents[ref.Kind] = ref
+		}
+	}
+	return parents
+}
+
+func ownerGroup(ref metav1.OwnerReference) string {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return ""
+	}
+	return gv.Group
+}
+
+// immutableFields returns the values of the immutable fields of the Spec keyed by their JSON path
+func immutableFields(obj MetaObject) map[string]interface{} {
+	fields := make(map[string]interface{})
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	spec := v.FieldByName("Spec")
+	if !spec.IsValid() {
+		return fields
+	}
+
+	gatherImmutableFields(spec, "spec", obj.GetNamespace(), fields)
+	return fields
+}
+
+func gatherImmutableFields(v reflect.Value, path, namespace string, fields map[string]interface{}) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		jsonName := strings.Split(structField.Tag.Get("json"), ",")[0]
+		if jsonName == "" || jsonName == "-" {
+			continue
+		}
+
+		fieldPath := path + "." + jsonName
+		if structField.Tag.Get(ImmutableTag) == "true" {
+			fields[fieldPath] = immutableValue(v.Field(i), namespace)
+			continue
+		}
+
+		gatherImmutableFields(v.Field(i), fieldPath, namespace, fields)
+	}
+}
+
+// immutableValue returns the value of an immutable field. A reference without a namespace is given the namespace of
+// the object, as the defaulting webhook will have done, so an object stored before it was defaulted compares equal.
+func immutableValue(v reflect.Value, namespace string) interface{} {
+	if v.Type() == reflect.PtrTo(knownTypeReferenceType) && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if v.Type() == knownTypeReferenceType {
+		ref := v.Interface().(KnownTypeReference)
+		if ref.Name != "" && ref.Namespace == "" {
+			ref.Namespace = namespace
+		}
+		return ref
+	}
+
+	return v.Interface()
+}
+
+func immutablePath(path string) *field.Path {
+	parts := strings.Split(path, ".")
+	return field.NewPath(parts[0], parts[1:]...)
+}
+
+func immutableMessage() string {
+	return fmt.Sprintf("field is immutable; set the %q annotation to \"true\" to delete and recreate the resource", RecreateAnnotation)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// DeletionProtectionAnnotation is the key which protects an object from deletion. When the value is "true", the
+	// object can not be deleted until the annotation is removed. When the value is "false", the object is not protected
+	// even if its kind is protected by policy.
+	DeletionProtectionAnnotation = "x-deletion-protection"
+
+	// DeletionBlockedCondition is True when the object has been deleted, or changed in a way which requires the Azure
+	// resource to be recreated, but the Azure resource has not been deleted as the object is protected
+	DeletionBlockedCondition ConditionType = "DeletionBlocked"
+)
+
+var (
+	protectionMu   sync.RWMutex
+	protectedKinds = make(map[schema.GroupKind]bool)
+)
+
+// ConfigureDeletionProtection sets the kinds which are protected from deletion across the cluster, unless an object
+// opts out via the DeletionProtectionAnnotation
+func ConfigureDeletionProtection(kinds []schema.GroupKind) {
+	protectionMu.Lock()
+	defer protectionMu.Unlock()
+
+	protectedKinds = make(map[schema.GroupKind]bool, len(kinds))
+	for _, kind := range kinds {
+		protectedKinds[kind] = true
+	}
+}
+
+// IsDeletionProtected returns true if the object of the given kind may not be deleted, either as it has been annotated
+// with the DeletionProtectionAnnotation or as its kind is protected by policy
+func IsDeletionProtected(gk schema.GroupKind, obj metav1.Object) bool {
+	switch strings.ToLower(obj.GetAnnotations()[DeletionProtectionAnnotation]) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	protectionMu.RLock()
+	defer protectionMu.RUnlock()
+
+	return protectedKinds[gk]
+}
+
+// ValidateDeletionAllowed returns a Forbidden error if the object of the given kind is protected from deletion
+func ValidateDeletionAllowed(gk schema.GroupKind, obj metav1.Object) error {
+	if !IsDeletionProtected(gk, obj) {
+		return nil
+	}
+
+	return apierrors.NewForbidden(schema.GroupResource{Group: gk.Group, Resource: gk.Kind}, obj.GetName(), DeletionProtectedError(gk, obj))
+}
+
+// DeletionProtectedError describes why the object of the given kind can not be deleted
+func DeletionProtectedError(gk schema.GroupKind, obj metav1.Object) error {
+	if obj.GetAnnotations()[DeletionProtectionAnnotation] != "" {
+		return fmt.Errorf("deletion protection is enabled; remove the %q annotation to allow deletion", DeletionProtectionAnnotation)
+	}
+
+	return fmt.Errorf("%s is protected from deletion by policy; set the %q annotation to \"false\" to allow deletion", gk, DeletionProtectionAnnotation)
+}
@@ -6,6 +6,7 @@ Licensed under the MIT license.
 package v1
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -15,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type (
@@ -50,6 +52,54 @@ type (
 		GetResourceGroupObjectRef() *KnownTypeReference
 	}
 
+	// OutputsExporter provides the outputs which should be exported from a resource once it has been provisioned
+	OutputsExporter interface {
+		GetOutputs() *OutputsSpec
+	}
+
+	// Allocator is implemented by objects which must be allocated part of what another object holds, such as an address
+	// prefix from the address space of a VirtualNetwork, before they can be applied to Azure
+	Allocator interface {
+		// Allocate records the allocation in the status of the object if it needs one and has none yet. It returns
+		// false if the allocation can not be made yet and should be tried again, along with a description of the
+		// allocation made, if any.
+		Allocate(ctx context.Context, c client.Client) (bool, string, error)
+	}
+
+	// FieldIndexed is implemented by types which need field indexes beyond the ones every kind has, such as for the
+	// webhooks of other kinds to look them up without listing them all. The indexes are registered along with the
+	// controller of the type.
+	FieldIndexed interface {
+		IndexFields(indexer client.FieldIndexer) error
+	}
+
+	// OutputsSpec describes values of the Azure resource which should be exported to a ConfigMap and / or Secret in the
+	// namespace of the object, so that applications can consume them without reading the object's status
+	// +kubebuilder:object:generate=true
+	OutputsSpec struct {
+		// ConfigMapName is the name of the ConfigMap the outputs will be written to
+		// +optional
+		ConfigMapName string `json:"configMapName,omitempty"`
+
+		// SecretName is the name of the Secret the outputs will be written to
+		// +optional
+		SecretName string `json:"secretName,omitempty"`
+
+		// Values are the outputs to export
+		Values []OutputValue `json:"values,omitempty"`
+	}
+
+	// OutputValue is a single value exported from an Azure resource
+	OutputValue struct {
+		// Key is the key of the value within the ConfigMap or Secret
+		// +kubebuilder:validation:Required
+		Key string `json:"key"`
+
+		// JSONPath is a JSONPath expression evaluated against the Azure resource, eg. {.properties.ipConfigurations[0].id}
+		// +kubebuilder:validation:Required
+		JSONPath string `json:"jsonPath"`
+	}
+
 	NamespaceNamer interface {
 		GetNamespace() string
 		SetNamespace(string)
@@ -66,6 +116,7 @@ type (
 	}
 )
 
+// SpecSignature returns a hash of the spec of the object, which changes whenever the spec to apply to Azure changes
 func SpecSignature(metaObject MetaObject) (string, error) {
 	// Convert the resource to unstructured for easier comparison later.
 	unObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(metaObject)
@@ -82,6 +133,9 @@ func SpecSignature(metaObject MetaObject) (string, error) {
 		return "", errors.New("unable to find spec within unstructured MetaObject")
 	}
 
+	// outputs are written to Kubernetes rather than applied to Azure, so changing them must not redeploy the resource
+	delete(spec, "outputs")
+
 	bits, err := json.Marshal(spec)
 	if err != nil {
 		return "", fmt.Errorf("unable to marshal spec of unstructured MetaObject with: %w", err)
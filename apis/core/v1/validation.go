@@ -0,0 +1,51 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// InvalidError aggregates the field errors of an object of the given group and kind into a single Invalid status
+// error, or returns nil if there are no errors
+func InvalidError(gk schema.GroupKind, name string, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(gk, name, allErrs)
+}
+
+// ValidateAPIVersion ensures the API version is set and is one of the API versions the type is able to apply
+func ValidateAPIVersion(apiVersion string, supported []string, fldPath *field.Path) field.ErrorList {
+	if apiVersion == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+
+	for _, version := range supported {
+		if apiVersion == version {
+			return nil
+		}
+	}
+
+	return field.ErrorList{field.NotSupported(fldPath, apiVersion, supported)}
+}
+
+// ValidateUpdate validates the spec of the new object along with any change to its immutable fields, returning the
+// errors as a single Invalid status error
+func ValidateUpdate(gk schema.GroupKind, old runtime.Object, new MetaObject, specErrs field.ErrorList) error {
+	oldMetaObj, ok := old.(MetaObject)
+	if !ok {
+		return fmt.Errorf("expected old object to be a %s, but was %T", gk.Kind, old)
+	}
+
+	return InvalidError(gk, new.GetName(), append(specErrs, ValidateImmutableFields(oldMetaObj, new)...))
+}
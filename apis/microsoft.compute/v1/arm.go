@@ -0,0 +1,92 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+const (
+	// osDiskCreateOption creates the operating system disk from the image of the storage profile
+	osDiskCreateOption = "FromImage"
+	// dataDiskCreateOption attaches an existing managed disk
+	dataDiskCreateOption = "Attach"
+)
+
+// The ToARM and FromARM conversions of the types of this group are generated into zz_generated.arm.go from the arm
+// tags of their fields. The types and functions below reshape the fields whose ARM properties differ from the Spec.
+
+type (
+	// +kubebuilder:object:generate=false
+	virtualMachineNetworkProfileARM struct {
+		NetworkInterfaces []virtualMachineNetworkInterfaceARM `json:"networkInterfaces,omitempty"`
+	}
+
+	// +kubebuilder:object:generate=false
+	virtualMachineNetworkInterfaceARM struct {
+		ID         string `json:"id"`
+		Properties struct {
+			Primary bool `json:"primary"`
+		} `json:"properties"`
+	}
+
+	// +kubebuilder:object:generate=false
+	virtualMachineOSDiskARM struct {
+		*OSDisk
+		CreateOption string `json:"createOption"`
+	}
+
+	// +kubebuilder:object:generate=false
+	virtualMachineDataDiskARM struct {
+		CreateOption string                   `json:"createOption"`
+		Lun          int                      `json:"lun"`
+		ManagedDisk  *azcorev1.ARMIDReference `json:"managedDisk"`
+	}
+)
+
+// networkProfileToARM attaches the network interfaces to the virtual machine, the first of them being its primary
+// network interface
+func networkProfileToARM(nics []azcorev1.ARMIDReference) *virtualMachineNetworkProfileARM {
+	if len(nics) == 0 {
+		return nil
+	}
+
+	profile := new(virtualMachineNetworkProfileARM)
+	for i, nic := range nics {
+		armNIC := virtualMachineNetworkInterfaceARM{ID: nic.ID}
+		armNIC.Properties.Primary = i == 0
+		profile.NetworkInterfaces = append(profile.NetworkInterfaces, armNIC)
+	}
+
+	return profile
+}
+
+// osDiskToARM creates the operating system disk from the image of the storage profile
+func osDiskToARM(disk *OSDisk) *virtualMachineOSDiskARM {
+	if disk == nil {
+		return nil
+	}
+
+	return &virtualMachineOSDiskARM{
+		OSDisk:       disk,
+		CreateOption: osDiskCreateOption,
+	}
+}
+
+// dataDisksToARM attaches each data disk at the LUN of its index, so the LUN of a disk follows its position in the
+// storage profile
+func dataDisksToARM(disks []azcorev1.ARMIDReference) []virtualMachineDataDiskARM {
+	var result []virtualMachineDataDiskARM
+	for i := range disks {
+		result = append(result, virtualMachineDataDiskARM{
+			CreateOption: dataDiskCreateOption,
+			Lun:          i,
+			ManagedDisk:  &disks[i],
+		})
+	}
+
+	return result
+}
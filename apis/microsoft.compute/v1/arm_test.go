@@ -0,0 +1,97 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type fakeResolver map[string]string
+
+func (f fakeResolver) ResolveARMID(_ context.Context, ref azcorev1.KnownTypeReference, _, kind string) (string, error) {
+	return f[kind+"/"+ref.Name], nil
+}
+
+func TestVirtualMachine_ToARM(t *testing.T) {
+	vm := &VirtualMachine{
+		Spec: VirtualMachineSpec{
+			APIVersion: "2019-12-01",
+			Location:   "westus2",
+			Properties: &VirtualMachineSpecProperties{
+				HardwareProfile: &HardwareProfile{VMSize: "Standard_D2s_v3"},
+				NetworkInterfaceRefs: []azcorev1.KnownTypeReference{
+					{Name: "nic-1"},
+					{Name: "nic-2"},
+				},
+				StorageProfile: &StorageProfile{
+					OSDisk: &OSDisk{Caching: "ReadWrite"},
+					DataDiskRefs: []azcorev1.KnownTypeReference{
+						{Name: "data-0"},
+						{Name: "data-1"},
+					},
+				},
+			},
+		},
+	}
+
+	resolver := fakeResolver{
+		"NetworkInterface/nic-1": "/nics/nic-1",
+		"NetworkInterface/nic-2": "/nics/nic-2",
+		"Disk/data-0":            "/disks/data-0",
+		"Disk/data-1":            "/disks/data-1",
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	res, err := vm.ToARM(context.Background(), resolver)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(res.Type).To(gomega.Equal("Microsoft.Compute/virtualMachines"))
+
+	var props map[string]interface{}
+	g.Expect(json.Unmarshal(res.Properties, &props)).To(gomega.Succeed())
+
+	nics := props["networkProfile"].(map[string]interface{})["networkInterfaces"].([]interface{})
+	g.Expect(nics).To(gomega.HaveLen(2))
+	g.Expect(nics[0]).To(gomega.HaveKeyWithValue("properties", map[string]interface{}{"primary": true}))
+	g.Expect(nics[1]).To(gomega.HaveKeyWithValue("properties", map[string]interface{}{"primary": false}))
+
+	storage := props["storageProfile"].(map[string]interface{})
+	g.Expect(storage["osDisk"]).To(gomega.HaveKeyWithValue("createOption", "FromImage"))
+	g.Expect(storage["osDisk"]).To(gomega.HaveKeyWithValue("caching", "ReadWrite"))
+
+	dataDisks := storage["dataDisks"].([]interface{})
+	g.Expect(dataDisks).To(gomega.HaveLen(2))
+	g.Expect(dataDisks[0]).To(gomega.HaveKeyWithValue("lun", float64(0)))
+	g.Expect(dataDisks[1]).To(gomega.HaveKeyWithValue("lun", float64(1)))
+	g.Expect(dataDisks[1]).To(gomega.HaveKeyWithValue("createOption", "Attach"))
+	g.Expect(dataDisks[1]).To(gomega.HaveKeyWithValue("managedDisk", map[string]interface{}{"id": "/disks/data-1"}))
+}
+
+func TestVirtualMachine_ToARM_FailsOnUnprovisionedDisk(t *testing.T) {
+	vm := &VirtualMachine{
+		Spec: VirtualMachineSpec{
+			APIVersion: "2019-12-01",
+			Location:   "westus2",
+			Properties: &VirtualMachineSpecProperties{
+				StorageProfile: &StorageProfile{
+					DataDiskRefs: []azcorev1.KnownTypeReference{
+						{Name: "pending"},
+					},
+				},
+			},
+		},
+	}
+
+	// the VM is not applied until its disks exist, so each disk keeps the LUN of its position
+	g := gomega.NewGomegaWithT(t)
+	_, err := vm.ToARM(context.Background(), fakeResolver{})
+	g.Expect(azcorev1.IsUnresolvedReference(err)).To(gomega.BeTrue())
+}
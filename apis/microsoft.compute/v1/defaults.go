@@ -0,0 +1,11 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+const (
+	// defaultAPIVersion is the Microsoft.Compute API version of the newest version of the group
+	defaultAPIVersion = "2019-12-01"
+)
@@ -0,0 +1,104 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// DiskCreationData describes the source the disk is created from
+	DiskCreationData struct {
+		// +kubebuilder:validation:Enum=Empty;Copy;FromImage;Import
+		CreateOption     string `json:"createOption"`
+		SourceResourceID string `json:"sourceResourceId,omitempty"`
+		SourceURI        string `json:"sourceUri,omitempty"`
+	}
+
+	// DiskSpecProperties are the resource specific properties
+	DiskSpecProperties struct {
+		CreationData *DiskCreationData `json:"creationData,omitempty" immutable:"true"`
+		// DiskSizeGB is the size of the disk, which may only be increased once the disk has been created
+		DiskSizeGB int `json:"diskSizeGB,omitempty"`
+		// +kubebuilder:validation:Enum=Linux;Windows
+		OSType string `json:"osType,omitempty"`
+	}
+
+	// DiskSpec defines the desired state of Disk
+	DiskSpec struct {
+		// +k8s:conversion-gen=false
+		APIVersion string `json:"apiVersion"`
+
+		// ResourceGroupRef is the Azure Resource Group the Disk resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
+
+		// Location of the Disk in Azure, which must be the location of the VirtualMachine it is attached to
+		// +kubebuilder:validation:Required
+		Location string `json:"location" immutable:"true"`
+
+		// SKU is the storage account type of the Disk
+		// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;StandardSSD_LRS;UltraSSD_LRS
+		SKU string `json:"sku,omitempty"`
+
+		// Zones the Disk is allocated within
+		// +optional
+		Zones []string `json:"zones,omitempty" immutable:"true"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the Disk
+		Properties *DiskSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// DiskStatus defines the observed state of Disk
+	DiskStatus struct {
+		ID string `json:"id,omitempty"`
+		// +k8s:conversion-gen=false
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+		// DiskState is the attachment state of the disk, eg. Unattached or Attached
+		// +k8s:conversion-gen=false
+		DiskState string `json:"diskState,omitempty" arm:"diskState"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+	// +kubebuilder:subresource:status
+	// +kubebuilder:storageversion
+
+	// Disk is the Schema for the disks API
+	Disk struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   DiskSpec   `json:"spec,omitempty"`
+		Status DiskStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// DiskList contains a list of Disk
+	DiskList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []Disk `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&Disk{}, &DiskList{})
+}
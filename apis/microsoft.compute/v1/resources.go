@@ -0,0 +1,50 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+func (disk *Disk) GetResourceGroupObjectRef() *azcorev1.KnownTypeReference {
+	return disk.Spec.ResourceGroupRef
+}
+
+func (vm *VirtualMachine) GetResourceGroupObjectRef() *azcorev1.KnownTypeReference {
+	return vm.Spec.ResourceGroupRef
+}
+
+func (*Disk) ResourceType() string {
+	return "Microsoft.Compute/disks"
+}
+
+func (*VirtualMachine) ResourceType() string {
+	return "Microsoft.Compute/virtualMachines"
+}
+
+func (disk *Disk) GetOutputs() *azcorev1.OutputsSpec {
+	return disk.Spec.Outputs
+}
+
+func (vm *VirtualMachine) GetOutputs() *azcorev1.OutputsSpec {
+	return vm.Spec.Outputs
+}
+
+func (disk *Disk) GetConditions() azcorev1.Conditions {
+	return disk.Status.Conditions
+}
+
+func (disk *Disk) SetConditions(conditions azcorev1.Conditions) {
+	disk.Status.Conditions = conditions
+}
+
+func (vm *VirtualMachine) GetConditions() azcorev1.Conditions {
+	return vm.Status.Conditions
+}
+
+func (vm *VirtualMachine) SetConditions(conditions azcorev1.Conditions) {
+	vm.Status.Conditions = conditions
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+const (
+	diskCreateOptionCopy   = "Copy"
+	diskCreateOptionEmpty  = "Empty"
+	diskCreateOptionImport = "Import"
+)
+
+var (
+	// supportedAPIVersions are the Microsoft.Compute API versions the types in this group are able to apply
+	supportedAPIVersions = []string{defaultAPIVersion}
+)
+
+func (r *Disk) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	creationDataPath := specPath.Child("properties", "creationData")
+	if r.Spec.Properties == nil || r.Spec.Properties.CreationData == nil {
+		return append(allErrs, field.Required(creationDataPath, ""))
+	}
+
+	props := r.Spec.Properties
+	switch props.CreationData.CreateOption {
+	case diskCreateOptionEmpty:
+		if props.DiskSizeGB <= 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("properties", "diskSizeGB"), "required when createOption is Empty"))
+		}
+	case diskCreateOptionCopy:
+		if props.CreationData.SourceResourceID == "" {
+			allErrs = append(allErrs, field.Required(creationDataPath.Child("sourceResourceId"), "required when createOption is Copy"))
+		}
+	case diskCreateOptionImport:
+		if props.CreationData.SourceURI == "" {
+			allErrs = append(allErrs, field.Required(creationDataPath.Child("sourceUri"), "required when createOption is Import"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *VirtualMachine) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	propertiesPath := specPath.Child("properties")
+	if r.Spec.Properties == nil {
+		return append(allErrs, field.Required(propertiesPath, ""))
+	}
+
+	return append(allErrs, r.Spec.Properties.validate(propertiesPath)...)
+}
+
+func (vmp *VirtualMachineSpecProperties) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if vmp.HardwareProfile == nil || vmp.HardwareProfile.VMSize == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("hardwareProfile", "vmSize"), ""))
+	}
+
+	if len(vmp.NetworkInterfaceRefs) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkInterfaceRefs"), "at least one network interface is required"))
+	}
+
+	osProfilePath := fldPath.Child("osProfile")
+	if vmp.OSProfile == nil {
+		return append(allErrs, field.Required(osProfilePath, ""))
+	}
+
+	if vmp.OSProfile.AdminUsername == "" {
+		allErrs = append(allErrs, field.Required(osProfilePath.Child("adminUsername"), ""))
+	}
+
+	// passwords are not supported, so an SSH key is the only way to sign in
+	linux := vmp.OSProfile.LinuxConfiguration
+	if linux == nil || linux.SSH == nil || len(linux.SSH.PublicKeys) == 0 {
+		msg := "at least one SSH public key is required"
+		allErrs = append(allErrs, field.Required(osProfilePath.Child("linuxConfiguration", "ssh", "publicKeys"), msg))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// HardwareProfile specifies the size of the virtual machine
+	HardwareProfile struct {
+		VMSize string `json:"vmSize"`
+	}
+
+	// ImageReference is the marketplace image the operating system disk is created from
+	ImageReference struct {
+		Offer     string `json:"offer,omitempty"`
+		Publisher string `json:"publisher,omitempty"`
+		SKU       string `json:"sku,omitempty"`
+		Version   string `json:"version,omitempty"`
+	}
+
+	// ManagedDiskParameters are the parameters of the managed disk created for the operating system
+	ManagedDiskParameters struct {
+		// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;StandardSSD_LRS;UltraSSD_LRS
+		StorageAccountType string `json:"storageAccountType,omitempty"`
+	}
+
+	// OSDisk is the operating system disk, which is created from the image and deleted along with the virtual machine
+	OSDisk struct {
+		// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
+		Caching     string                 `json:"caching,omitempty"`
+		DiskSizeGB  int                    `json:"diskSizeGB,omitempty"`
+		ManagedDisk *ManagedDiskParameters `json:"managedDisk,omitempty"`
+		Name        string                 `json:"name,omitempty"`
+	}
+
+	// StorageProfile describes the disks of the virtual machine
+	StorageProfile struct {
+		ImageReference *ImageReference `json:"imageReference,omitempty" immutable:"true"`
+		OSDisk         *OSDisk         `json:"osDisk,omitempty" arm:",convert=osDiskToARM"`
+		// DataDiskRefs are the disks attached to the virtual machine, each at the LUN of its index
+		DataDiskRefs []azcorev1.KnownTypeReference `json:"dataDiskRefs,omitempty" group:"microsoft.compute.infra.azure.com" kind:"Disk" arm:"dataDisks,convert=dataDisksToARM"`
+	}
+
+	// SSHPublicKey is a public key allowed to sign in to the virtual machine
+	SSHPublicKey struct {
+		// Path on the virtual machine the key is written to, eg. /home/azureuser/.ssh/authorized_keys
+		Path    string `json:"path"`
+		KeyData string `json:"keyData"`
+	}
+
+	// SSHConfiguration is the SSH configuration of a Linux virtual machine
+	SSHConfiguration struct {
+		PublicKeys []SSHPublicKey `json:"publicKeys,omitempty"`
+	}
+
+	// LinuxConfiguration is the operating system configuration of a Linux virtual machine
+	LinuxConfiguration struct {
+		DisablePasswordAuthentication bool              `json:"disablePasswordAuthentication,omitempty"`
+		SSH                           *SSHConfiguration `json:"ssh,omitempty"`
+	}
+
+	// OSProfile describes the operating system of the virtual machine. Only SSH keys are supported, so that no
+	// credentials are held in the spec.
+	OSProfile struct {
+		AdminUsername      string              `json:"adminUsername" immutable:"true"`
+		ComputerName       string              `json:"computerName,omitempty" immutable:"true"`
+		LinuxConfiguration *LinuxConfiguration `json:"linuxConfiguration,omitempty"`
+	}
+
+	// VirtualMachineSpecProperties are the resource specific properties
+	VirtualMachineSpecProperties struct {
+		HardwareProfile *HardwareProfile `json:"hardwareProfile,omitempty"`
+		// NetworkInterfaceRefs are the network interfaces of the virtual machine, of which the first is the primary
+		NetworkInterfaceRefs []azcorev1.KnownTypeReference `json:"networkInterfaceRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"NetworkInterface" arm:"networkProfile,convert=networkProfileToARM"`
+		OSProfile            *OSProfile                    `json:"osProfile,omitempty"`
+		StorageProfile       *StorageProfile               `json:"storageProfile,omitempty"`
+	}
+
+	// VirtualMachineSpec defines the desired state of VirtualMachine
+	VirtualMachineSpec struct {
+		// +k8s:conversion-gen=false
+		APIVersion string `json:"apiVersion"`
+
+		// ResourceGroupRef is the Azure Resource Group the VirtualMachine resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
+
+		// Location of the VirtualMachine in Azure, which must be the location of its network interfaces
+		// +kubebuilder:validation:Required
+		Location string `json:"location" immutable:"true"`
+
+		// Zones the VirtualMachine is allocated within
+		// +optional
+		Zones []string `json:"zones,omitempty" immutable:"true"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the VirtualMachine
+		Properties *VirtualMachineSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// VirtualMachineStatus defines the observed state of VirtualMachine
+	VirtualMachineStatus struct {
+		ID string `json:"id,omitempty"`
+		// +k8s:conversion-gen=false
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+		// VMID is the unique ID Azure assigned to the virtual machine
+		// +k8s:conversion-gen=false
+		VMID string `json:"vmId,omitempty" arm:"vmId"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+	// +kubebuilder:subresource:status
+	// +kubebuilder:storageversion
+
+	// VirtualMachine is the Schema for the virtualmachines API
+	VirtualMachine struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   VirtualMachineSpec   `json:"spec,omitempty"`
+		Status VirtualMachineStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VirtualMachineList contains a list of VirtualMachine
+	VirtualMachineList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []VirtualMachine `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachine{}, &VirtualMachineList{})
+}
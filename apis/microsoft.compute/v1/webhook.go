@@ -0,0 +1,102 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+// log is for logging in this package.
+var disklog = logf.Log.WithName("disk-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-compute-infra-azure-com-v1-disk,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.compute.infra.azure.com,resources=disks,verbs=create;update,versions=v1,name=default.disk.infra.azure.com
+
+var _ webhook.Defaulter = &Disk{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Disk) Default() {
+	disklog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-compute-infra-azure-com-v1-disk,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.compute.infra.azure.com,resources=disks,versions=v1,name=validation.disk.infra.azure.com
+
+var _ webhook.Validator = &Disk{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Disk) ValidateCreate() error {
+	disklog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("Disk").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Disk) ValidateUpdate(old runtime.Object) error {
+	disklog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("Disk").GroupKind(), old, r, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Disk) ValidateDelete() error {
+	disklog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("Disk").GroupKind(), r)
+}
+
+// log is for logging in this package.
+var virtualmachinelog = logf.Log.WithName("virtualmachine-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-compute-infra-azure-com-v1-virtualmachine,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.compute.infra.azure.com,resources=virtualmachines,verbs=create;update,versions=v1,name=default.virtualmachine.infra.azure.com
+
+var _ webhook.Defaulter = &VirtualMachine{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *VirtualMachine) Default() {
+	virtualmachinelog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-compute-infra-azure-com-v1-virtualmachine,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.compute.infra.azure.com,resources=virtualmachines,versions=v1,name=validation.virtualmachine.infra.azure.com
+
+var _ webhook.Validator = &VirtualMachine{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualMachine) ValidateCreate() error {
+	virtualmachinelog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("VirtualMachine").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualMachine) ValidateUpdate(old runtime.Object) error {
+	virtualmachinelog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("VirtualMachine").GroupKind(), old, r, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualMachine) ValidateDelete() error {
+	virtualmachinelog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("VirtualMachine").GroupKind(), r)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20191201
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.compute/v1"
+)
+
+const (
+	apiVersion = "2019-12-01"
+)
+
+func (src *Disk) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.Disk)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20191201_Disk_To_v1_Disk(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
+	return nil
+}
+
+func (dst *Disk) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.Disk)
+
+	if err := Convert_v1_Disk_To_v20191201_Disk(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
+
+func (src *VirtualMachine) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.VirtualMachine)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20191201_VirtualMachine_To_v1_VirtualMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
+	return nil
+}
+
+func (dst *VirtualMachine) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.VirtualMachine)
+
+	if err := Convert_v1_VirtualMachine_To_v20191201_VirtualMachine(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
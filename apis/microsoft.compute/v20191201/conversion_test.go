@@ -0,0 +1,33 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20191201
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.compute/v1"
+	"github.com/Azure/k8s-infra/internal/test"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Hub   conversion.Hub
+		Spoke conversion.Convertible
+	}{
+		{Name: "Disk", Hub: &v1.Disk{}, Spoke: &Disk{}},
+		{Name: "VirtualMachine", Hub: &v1.VirtualMachine{}, Spoke: &VirtualMachine{}},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			test.FuzzConversion(t, c.Hub, c.Spoke)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20191201
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// DiskCreationData describes the source the disk is created from
+	DiskCreationData struct {
+		// +kubebuilder:validation:Enum=Empty;Copy;FromImage;Import
+		CreateOption     string `json:"createOption"`
+		SourceResourceID string `json:"sourceResourceId,omitempty"`
+		SourceURI        string `json:"sourceUri,omitempty"`
+	}
+
+	// DiskSpecProperties are the resource specific properties
+	DiskSpecProperties struct {
+		CreationData *DiskCreationData `json:"creationData,omitempty"`
+		// DiskSizeGB is the size of the disk, which may only be increased once the disk has been created
+		DiskSizeGB int `json:"diskSizeGB,omitempty"`
+		// +kubebuilder:validation:Enum=Linux;Windows
+		OSType string `json:"osType,omitempty"`
+	}
+
+	// DiskSpec defines the desired state of Disk
+	DiskSpec struct {
+		// ResourceGroupRef is the Azure Resource Group the Disk resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef"`
+
+		// Location of the Disk in Azure, which must be the location of the VirtualMachine it is attached to
+		// +kubebuilder:validation:Required
+		Location string `json:"location"`
+
+		// SKU is the storage account type of the Disk
+		// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;StandardSSD_LRS;UltraSSD_LRS
+		SKU string `json:"sku,omitempty"`
+
+		// Zones the Disk is allocated within
+		// +optional
+		Zones []string `json:"zones,omitempty"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the Disk
+		Properties *DiskSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// DiskStatus defines the observed state of Disk
+	DiskStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// Disk is the Schema for the disks API
+	Disk struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   DiskSpec   `json:"spec,omitempty"`
+		Status DiskStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// DiskList contains a list of Disk
+	DiskList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []Disk `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&Disk{}, &DiskList{})
+}
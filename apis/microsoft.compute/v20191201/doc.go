@@ -0,0 +1,7 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// +k8s:conversion-gen=github.com/Azure/k8s-infra/apis/microsoft.compute/v1
+package v20191201
@@ -0,0 +1,141 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20191201
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// HardwareProfile specifies the size of the virtual machine
+	HardwareProfile struct {
+		VMSize string `json:"vmSize"`
+	}
+
+	// ImageReference is the marketplace image the operating system disk is created from
+	ImageReference struct {
+		Offer     string `json:"offer,omitempty"`
+		Publisher string `json:"publisher,omitempty"`
+		SKU       string `json:"sku,omitempty"`
+		Version   string `json:"version,omitempty"`
+	}
+
+	// ManagedDiskParameters are the parameters of the managed disk created for the operating system
+	ManagedDiskParameters struct {
+		// +kubebuilder:validation:Enum=Standard_LRS;Premium_LRS;StandardSSD_LRS;UltraSSD_LRS
+		StorageAccountType string `json:"storageAccountType,omitempty"`
+	}
+
+	// OSDisk is the operating system disk, which is created from the image and deleted along with the virtual machine
+	OSDisk struct {
+		// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
+		Caching     string                 `json:"caching,omitempty"`
+		DiskSizeGB  int                    `json:"diskSizeGB,omitempty"`
+		ManagedDisk *ManagedDiskParameters `json:"managedDisk,omitempty"`
+		Name        string                 `json:"name,omitempty"`
+	}
+
+	// StorageProfile describes the disks of the virtual machine
+	StorageProfile struct {
+		ImageReference *ImageReference `json:"imageReference,omitempty"`
+		OSDisk         *OSDisk         `json:"osDisk,omitempty"`
+		// DataDiskRefs are the disks attached to the virtual machine, each at the LUN of its index
+		DataDiskRefs []azcorev1.KnownTypeReference `json:"dataDiskRefs,omitempty"`
+	}
+
+	// SSHPublicKey is a public key allowed to sign in to the virtual machine
+	SSHPublicKey struct {
+		// Path on the virtual machine the key is written to, eg. /home/azureuser/.ssh/authorized_keys
+		Path    string `json:"path"`
+		KeyData string `json:"keyData"`
+	}
+
+	// SSHConfiguration is the SSH configuration of a Linux virtual machine
+	SSHConfiguration struct {
+		PublicKeys []SSHPublicKey `json:"publicKeys,omitempty"`
+	}
+
+	// LinuxConfiguration is the operating system configuration of a Linux virtual machine
+	LinuxConfiguration struct {
+		DisablePasswordAuthentication bool              `json:"disablePasswordAuthentication,omitempty"`
+		SSH                           *SSHConfiguration `json:"ssh,omitempty"`
+	}
+
+	// OSProfile describes the operating system of the virtual machine. Only SSH keys are supported, so that no
+	// credentials are held in the spec.
+	OSProfile struct {
+		AdminUsername      string              `json:"adminUsername"`
+		ComputerName       string              `json:"computerName,omitempty"`
+		LinuxConfiguration *LinuxConfiguration `json:"linuxConfiguration,omitempty"`
+	}
+
+	// VirtualMachineSpecProperties are the resource specific properties
+	VirtualMachineSpecProperties struct {
+		HardwareProfile *HardwareProfile `json:"hardwareProfile,omitempty"`
+		// NetworkInterfaceRefs are the network interfaces of the virtual machine, of which the first is the primary
+		NetworkInterfaceRefs []azcorev1.KnownTypeReference `json:"networkInterfaceRefs,omitempty"`
+		OSProfile            *OSProfile                    `json:"osProfile,omitempty"`
+		StorageProfile       *StorageProfile               `json:"storageProfile,omitempty"`
+	}
+
+	// VirtualMachineSpec defines the desired state of VirtualMachine
+	VirtualMachineSpec struct {
+		// ResourceGroupRef is the Azure Resource Group the VirtualMachine resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef"`
+
+		// Location of the VirtualMachine in Azure, which must be the location of its network interfaces
+		// +kubebuilder:validation:Required
+		Location string `json:"location"`
+
+		// Zones the VirtualMachine is allocated within
+		// +optional
+		Zones []string `json:"zones,omitempty"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the VirtualMachine
+		Properties *VirtualMachineSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// VirtualMachineStatus defines the observed state of VirtualMachine
+	VirtualMachineStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VirtualMachine is the Schema for the virtualmachines API
+	VirtualMachine struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   VirtualMachineSpec   `json:"spec,omitempty"`
+		Status VirtualMachineStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VirtualMachineList contains a list of VirtualMachine
+	VirtualMachineList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []VirtualMachine `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachine{}, &VirtualMachineList{})
+}
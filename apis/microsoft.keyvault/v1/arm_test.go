@@ -0,0 +1,67 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	"github.com/Azure/k8s-infra/pkg/zips"
+)
+
+type emptyResolver struct{}
+
+func (emptyResolver) ResolveARMID(context.Context, azcorev1.KnownTypeReference, string, string) (string, error) {
+	return "", nil
+}
+
+func TestVault_ToARM_RequiresAccessPolicies(t *testing.T) {
+	vault := &Vault{
+		Spec: VaultSpec{
+			APIVersion: "2019-09-01",
+			Location:   "westus2",
+			Properties: &VaultSpecProperties{TenantID: tenantID},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	res, err := vault.ToARM(context.Background(), emptyResolver{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(string(res.Properties)).To(gomega.ContainSubstring(`"accessPolicies":[]`))
+}
+
+func TestVault_ToARM_OmitsPropertiesWithoutResolver(t *testing.T) {
+	vault := &Vault{
+		Spec: VaultSpec{
+			APIVersion: "2019-09-01",
+			Location:   "westus2",
+			Properties: &VaultSpecProperties{TenantID: tenantID},
+		},
+		Status: VaultStatus{ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv"},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	res, err := vault.ToARM(context.Background(), nil)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(res.ID).To(gomega.Equal(vault.Status.ID))
+	g.Expect(res.Properties).To(gomega.BeEmpty())
+}
+
+func TestVault_FromARM(t *testing.T) {
+	vault := new(Vault)
+	res := &zips.Resource{
+		ID:                "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv",
+		ProvisioningState: zips.SucceededProvisioningState,
+		Properties:        []byte(`{"vaultUri":"https://kv.vault.azure.net/"}`),
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(vault.FromARM(res)).To(gomega.Succeed())
+	g.Expect(vault.Status.VaultURI).To(gomega.Equal("https://kv.vault.azure.net/"))
+}
@@ -0,0 +1,15 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+const (
+	// defaultAPIVersion is the Microsoft.KeyVault API version of the newest version of the group
+	defaultAPIVersion = "2019-09-01"
+	// defaultSKUFamily is the only family of vault SKUs
+	defaultSKUFamily = "A"
+	// defaultSKUName is the tier which stores keys in software rather than HSMs
+	defaultSKUName = "standard"
+)
@@ -0,0 +1,30 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+func (v *Vault) GetResourceGroupObjectRef() *azcorev1.KnownTypeReference {
+	return v.Spec.ResourceGroupRef
+}
+
+func (*Vault) ResourceType() string {
+	return "Microsoft.KeyVault/vaults"
+}
+
+func (v *Vault) GetOutputs() *azcorev1.OutputsSpec {
+	return v.Spec.Outputs
+}
+
+func (v *Vault) GetConditions() azcorev1.Conditions {
+	return v.Status.Conditions
+}
+
+func (v *Vault) SetConditions(conditions azcorev1.Conditions) {
+	v.Status.Conditions = conditions
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+var (
+	// supportedAPIVersions are the Microsoft.KeyVault API versions the types in this group are able to apply
+	supportedAPIVersions = []string{defaultAPIVersion}
+
+	// vaultNameRegex matches the names Azure allows for vaults, which are part of the DNS name of the vault URI
+	vaultNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$`)
+	uuidRegex      = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+func (r *Vault) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+	if !vaultNameRegex.MatchString(r.Name) || strings.Contains(r.Name, "--") {
+		msg := "must be 3 to 24 letters, numbers and single hyphens, starting with a letter"
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), r.Name, msg))
+	}
+
+	allErrs = append(allErrs, azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))...)
+	propertiesPath := specPath.Child("properties")
+	if r.Spec.Properties == nil {
+		return append(allErrs, field.Required(propertiesPath, ""))
+	}
+
+	return append(allErrs, r.Spec.Properties.validate(propertiesPath)...)
+}
+
+func (vp *VaultSpecProperties) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := validateUUID(vp.TenantID, fldPath.Child("tenantId"))
+	for i, policy := range vp.AccessPolicies {
+		policyPath := fldPath.Child("accessPolicies").Index(i)
+		allErrs = append(allErrs, validateUUID(policy.TenantID, policyPath.Child("tenantId"))...)
+		allErrs = append(allErrs, validateUUID(policy.ObjectID, policyPath.Child("objectId"))...)
+	}
+
+	return allErrs
+}
+
+// validatePurgeProtection ensures purge protection is not disabled once it has been enabled, which Azure does not
+// allow
+func (r *Vault) validatePurgeProtection(old runtime.Object) field.ErrorList {
+	oldVault, ok := old.(*Vault)
+	if !ok || oldVault.Spec.Properties == nil || !oldVault.Spec.Properties.EnablePurgeProtection {
+		return nil
+	}
+
+	if r.Spec.Properties == nil || !r.Spec.Properties.EnablePurgeProtection {
+		fldPath := field.NewPath("spec", "properties", "enablePurgeProtection")
+		return field.ErrorList{field.Forbidden(fldPath, "purge protection can not be disabled once enabled")}
+	}
+
+	return nil
+}
+
+func validateUUID(value string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+
+	if !uuidRegex.MatchString(value) {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be a UUID")}
+	}
+
+	return nil
+}
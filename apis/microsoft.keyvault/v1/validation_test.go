@@ -0,0 +1,107 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const tenantID = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+
+func TestVault_ValidateCreate(t *testing.T) {
+	cases := []struct {
+		Name         string
+		VaultName    string
+		Properties   *VaultSpecProperties
+		ExpectFields []string
+	}{
+		{
+			Name:      "Valid",
+			VaultName: "kv-app-1",
+			Properties: &VaultSpecProperties{
+				TenantID: tenantID,
+				AccessPolicies: []AccessPolicyEntry{
+					{TenantID: tenantID, ObjectID: "00000000-0000-0000-0000-000000000001"},
+				},
+			},
+		},
+		{
+			Name:         "InvalidName",
+			VaultName:    "kv--app",
+			Properties:   &VaultSpecProperties{TenantID: tenantID},
+			ExpectFields: []string{"metadata.name"},
+		},
+		{
+			Name:         "MissingProperties",
+			VaultName:    "kv-app-1",
+			ExpectFields: []string{"spec.properties"},
+		},
+		{
+			Name:      "InvalidAccessPolicy",
+			VaultName: "kv-app-1",
+			Properties: &VaultSpecProperties{
+				TenantID: tenantID,
+				AccessPolicies: []AccessPolicyEntry{
+					{TenantID: tenantID, ObjectID: "app"},
+					{ObjectID: "00000000-0000-0000-0000-000000000001"},
+				},
+			},
+			ExpectFields: []string{"spec.properties.accessPolicies[0].objectId", "spec.properties.accessPolicies[1].tenantId"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			vault := &Vault{
+				ObjectMeta: metav1.ObjectMeta{Name: c.VaultName},
+				Spec: VaultSpec{
+					APIVersion: "2019-09-01",
+					Properties: c.Properties,
+				},
+			}
+
+			g := gomega.NewGomegaWithT(t)
+			expectInvalidFields(g, vault.ValidateCreate(), c.ExpectFields)
+		})
+	}
+}
+
+func TestVault_ValidateUpdate_PurgeProtection(t *testing.T) {
+	old := &Vault{
+		ObjectMeta: metav1.ObjectMeta{Name: "kv-app-1"},
+		Spec: VaultSpec{
+			APIVersion: "2019-09-01",
+			Properties: &VaultSpecProperties{TenantID: tenantID, EnablePurgeProtection: true},
+		},
+	}
+
+	vault := old.DeepCopy()
+	vault.Spec.Properties.EnablePurgeProtection = false
+
+	g := gomega.NewGomegaWithT(t)
+	expectInvalidFields(g, vault.ValidateUpdate(old), []string{"spec.properties.enablePurgeProtection"})
+	expectInvalidFields(g, old.ValidateUpdate(vault), nil)
+}
+
+func expectInvalidFields(g *gomega.GomegaWithT, err error, fields []string) {
+	if len(fields) == 0 {
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		return
+	}
+
+	g.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue(), "expected an Invalid error, but got %v", err)
+	status := err.(apierrors.APIStatus).Status()
+	var actual []string
+	for _, cause := range status.Details.Causes {
+		actual = append(actual, cause.Field)
+	}
+	g.Expect(actual).To(gomega.ConsistOf(fields))
+}
@@ -0,0 +1,61 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+// log is for logging in this package.
+var vaultlog = logf.Log.WithName("vault-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-keyvault-infra-azure-com-v1-vault,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.keyvault.infra.azure.com,resources=vaults,verbs=create;update,versions=v1,name=default.vault.infra.azure.com
+
+var _ webhook.Defaulter = &Vault{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Vault) Default() {
+	vaultlog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	if r.Spec.Properties != nil && r.Spec.Properties.SKU == nil {
+		r.Spec.Properties.SKU = &VaultSKU{Family: defaultSKUFamily, Name: defaultSKUName}
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-keyvault-infra-azure-com-v1-vault,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.keyvault.infra.azure.com,resources=vaults,versions=v1,name=validation.vault.infra.azure.com
+
+var _ webhook.Validator = &Vault{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Vault) ValidateCreate() error {
+	vaultlog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("Vault").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Vault) ValidateUpdate(old runtime.Object) error {
+	vaultlog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("Vault").GroupKind(), old, r, append(r.validateSpec(), r.validatePurgeProtection(old)...))
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Vault) ValidateDelete() error {
+	vaultlog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("Vault").GroupKind(), r)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20190901
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.keyvault/v1"
+)
+
+const (
+	apiVersion = "2019-09-01"
+)
+
+func (src *Vault) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.Vault)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20190901_Vault_To_v1_Vault(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
+	return nil
+}
+
+func (dst *Vault) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.Vault)
+
+	if err := Convert_v1_Vault_To_v20190901_Vault(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
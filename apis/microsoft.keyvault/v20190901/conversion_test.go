@@ -0,0 +1,32 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20190901
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.keyvault/v1"
+	"github.com/Azure/k8s-infra/internal/test"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Hub   conversion.Hub
+		Spoke conversion.Convertible
+	}{
+		{Name: "Vault", Hub: &v1.Vault{}, Spoke: &Vault{}},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			test.FuzzConversion(t, c.Hub, c.Spoke)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20190901
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// VaultSKU is the pricing tier of the vault
+	VaultSKU struct {
+		// +kubebuilder:validation:Enum=A
+		Family string `json:"family"`
+		// +kubebuilder:validation:Enum=standard;premium
+		Name string `json:"name"`
+	}
+
+	// AccessPolicyPermissions are the operations an identity is allowed to perform on each kind of object in the vault
+	AccessPolicyPermissions struct {
+		Certificates []string `json:"certificates,omitempty"`
+		Keys         []string `json:"keys,omitempty"`
+		Secrets      []string `json:"secrets,omitempty"`
+		Storage      []string `json:"storage,omitempty"`
+	}
+
+	// AccessPolicyEntry grants an Azure Active Directory identity access to the vault
+	AccessPolicyEntry struct {
+		// TenantID is the Azure Active Directory tenant of the identity
+		TenantID string `json:"tenantId"`
+		// ObjectID is the object ID of the user, service principal or security group granted access
+		ObjectID string `json:"objectId"`
+		// ApplicationID is the client acting on behalf of the identity, for compound identities
+		ApplicationID string                  `json:"applicationId,omitempty"`
+		Permissions   AccessPolicyPermissions `json:"permissions"`
+	}
+
+	// VaultSpecProperties are the resource specific properties
+	VaultSpecProperties struct {
+		// AccessPolicies are the identities granted access to the vault, each of which must be in the tenant of the
+		// vault
+		AccessPolicies               []AccessPolicyEntry `json:"accessPolicies,omitempty"`
+		EnabledForDeployment         bool                `json:"enabledForDeployment,omitempty"`
+		EnabledForDiskEncryption     bool                `json:"enabledForDiskEncryption,omitempty"`
+		EnabledForTemplateDeployment bool                `json:"enabledForTemplateDeployment,omitempty"`
+		// EnablePurgeProtection prevents the vault and its objects from being purged while soft deleted, and can not
+		// be disabled once enabled
+		EnablePurgeProtection bool      `json:"enablePurgeProtection,omitempty"`
+		EnableSoftDelete      *bool     `json:"enableSoftDelete,omitempty"`
+		SKU                   *VaultSKU `json:"sku,omitempty"`
+		// TenantID is the Azure Active Directory tenant used to authenticate requests to the vault
+		TenantID string `json:"tenantId"`
+	}
+
+	// VaultSpec defines the desired state of Vault
+	VaultSpec struct {
+		// ResourceGroupRef is the Azure Resource Group the Vault resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef"`
+
+		// Location of the Vault in Azure
+		// +kubebuilder:validation:Required
+		Location string `json:"location"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the Vault
+		// +kubebuilder:validation:Required
+		Properties *VaultSpecProperties `json:"properties"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret, eg. {.properties.vaultUri}
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// VaultStatus defines the observed state of Vault
+	VaultStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// Vault is the Schema for the vaults API
+	Vault struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   VaultSpec   `json:"spec,omitempty"`
+		Status VaultStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VaultList contains a list of Vault
+	VaultList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []Vault `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&Vault{}, &VaultList{})
+}
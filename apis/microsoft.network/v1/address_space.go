@@ -0,0 +1,455 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+const (
+	// subnetRefsIndexKey is the field index of VirtualNetworks which holds the "namespace/name" of each subnet they
+	// reference
+	subnetRefsIndexKey = "spec.properties.subnetRefs"
+	// peeringRefsIndexKey is the field index of VirtualNetworks which holds the "namespace/name" of each peering they
+	// reference
+	peeringRefsIndexKey = "spec.properties.virtualNetworkPeeringRefs"
+)
+
+// IndexFields indexes VirtualNetworks by the subnets and peerings they reference, so the webhooks of a subnet or
+// peering can find its VirtualNetwork without listing every VirtualNetwork in the namespace
+func (r *VirtualNetwork) IndexFields(indexer client.FieldIndexer) error {
+	indexes := map[string]func(*VirtualNetwork) []azcorev1.KnownTypeReference{
+		subnetRefsIndexKey:  (*VirtualNetwork).subnetRefs,
+		peeringRefsIndexKey: (*VirtualNetwork).peeringRefs,
+	}
+
+	for key, refs := range indexes {
+		refs := refs
+		if err := indexer.IndexField(&VirtualNetwork{}, key, func(obj runtime.Object) []string {
+			vnet, ok := obj.(*VirtualNetwork)
+			if !ok {
+				return []string{}
+			}
+
+			var keys []string
+			for _, ref := range refs(vnet) {
+				keys = append(keys, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}.String())
+			}
+			return keys
+		}); err != nil {
+			return fmt.Errorf("unable to setup field indexer for %s of VirtualNetworks with: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAddressSpace ensures the prefixes of the subnet are within the address space of the VirtualNetwork which
+// references it, and that they do not overlap the prefixes of the other subnets of that VirtualNetwork. The check is
+// skipped until the subnet is referenced by a VirtualNetwork.
+func (r *Subnet) validateAddressSpace() field.ErrorList {
+	reader := azcorev1.WebhookReader()
+	if reader == nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	propertiesPath := field.NewPath("spec", "properties")
+	vnet, err := findSubnetVirtualNetwork(ctx, reader, r)
+	if err != nil {
+		return field.ErrorList{field.InternalError(propertiesPath, err)}
+	}
+
+	if vnet == nil {
+		return nil
+	}
+
+	prefixes, paths := r.Spec.Properties.prefixes(propertiesPath)
+	var vnetNets []*net.IPNet
+	if vnet.Spec.Properties != nil && vnet.Spec.Properties.AddressSpace != nil {
+		vnetNets = parseCIDRs(vnet.Spec.Properties.AddressSpace.AddressPrefixes)
+	}
+
+	var allErrs field.ErrorList
+	for i, prefix := range prefixes {
+		_, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			// reported by validateSpec
+			continue
+		}
+
+		if !withinAny(ipNet, vnetNets) {
+			msg := fmt.Sprintf("is not within the address space of VirtualNetwork %q", objectKey(vnet))
+			allErrs = append(allErrs, field.Invalid(paths[i], prefix, msg))
+		}
+	}
+
+	siblings, err := subnetsOf(ctx, reader, vnet)
+	if err != nil {
+		return append(allErrs, field.InternalError(propertiesPath, err))
+	}
+
+	for _, sibling := range siblings {
+		if sibling.Namespace == r.Namespace && sibling.Name == r.Name {
+			continue
+		}
+
+		siblingNets := parseCIDRs(sibling.AddressPrefixes())
+		for i, prefix := range prefixes {
+			_, ipNet, err := net.ParseCIDR(prefix)
+			if err != nil {
+				continue
+			}
+
+			for _, siblingNet := range siblingNets {
+				if cidrsOverlap(ipNet, siblingNet) {
+					msg := fmt.Sprintf("overlaps with %s of Subnet %q in VirtualNetwork %q", siblingNet, objectKey(&sibling), objectKey(vnet))
+					allErrs = append(allErrs, field.Invalid(paths[i], prefix, msg))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateSubnetsWithinAddressSpace ensures the address space of the VirtualNetwork still contains the prefixes of
+// each of its existing subnets
+func (r *VirtualNetwork) validateSubnetsWithinAddressSpace() field.ErrorList {
+	reader := azcorev1.WebhookReader()
+	if reader == nil || r.Spec.Properties == nil || r.Spec.Properties.AddressSpace == nil {
+		return nil
+	}
+
+	prefixesPath := field.NewPath("spec", "properties", "addressSpace", "addressPrefixes")
+	subnets, err := subnetsOf(context.Background(), reader, r)
+	if err != nil {
+		return field.ErrorList{field.InternalError(prefixesPath, err)}
+	}
+
+	vnetNets := parseCIDRs(r.Spec.Properties.AddressSpace.AddressPrefixes)
+	var allErrs field.ErrorList
+	for _, subnet := range subnets {
+		for _, subnetNet := range parseCIDRs(subnet.AddressPrefixes()) {
+			if !withinAny(subnetNet, vnetNets) {
+				msg := fmt.Sprintf("must contain %s of Subnet %q", subnetNet, objectKey(&subnet))
+				allErrs = append(allErrs, field.Invalid(prefixesPath, r.Spec.Properties.AddressSpace.AddressPrefixes, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateAddressSpace ensures the address space of the VirtualNetwork which owns the peering does not overlap the
+// address space of the remote virtual network, as Azure will not peer virtual networks with overlapping ranges. The
+// check is skipped until both virtual networks are known.
+func (r *VirtualNetworkPeering) validateAddressSpace() field.ErrorList {
+	reader := azcorev1.WebhookReader()
+	if reader == nil || r.Spec.Properties == nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	propertiesPath := field.NewPath("spec", "properties")
+	vnet, err := findOwningVirtualNetwork(ctx, reader, r, peeringRefsIndexKey, (*VirtualNetwork).peeringRefs)
+	if err != nil {
+		return field.ErrorList{field.InternalError(propertiesPath, err)}
+	}
+
+	remote, err := findRemoteVirtualNetwork(ctx, reader, r)
+	if err != nil {
+		return field.ErrorList{field.InternalError(propertiesPath, err)}
+	}
+
+	if vnet == nil || remote == nil {
+		return nil
+	}
+
+	remotePath := propertiesPath.Child("remoteVirtualNetworkRef")
+	remoteValue := interface{}(r.Spec.Properties.RemoteVirtualNetworkRef)
+	if r.Spec.Properties.RemoteVirtualNetworkRef == nil {
+		remotePath = propertiesPath.Child("remoteVirtualNetworkId")
+		remoteValue = r.Spec.Properties.RemoteVirtualNetworkID
+	}
+
+	var allErrs field.ErrorList
+	for _, overlap := range overlappingAddressSpace(vnet, remote) {
+		msg := fmt.Sprintf("address space of VirtualNetwork %q overlaps with %s of VirtualNetwork %q", objectKey(vnet), overlap, objectKey(remote))
+		allErrs = append(allErrs, field.Invalid(remotePath, remoteValue, msg))
+	}
+
+	return allErrs
+}
+
+// validatePeeredAddressSpaces ensures the address space of the VirtualNetwork does not overlap the address space of
+// any virtual network it is peered with
+func (r *VirtualNetwork) validatePeeredAddressSpaces() field.ErrorList {
+	reader := azcorev1.WebhookReader()
+	if reader == nil || r.Spec.Properties == nil || r.Spec.Properties.AddressSpace == nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	prefixesPath := field.NewPath("spec", "properties", "addressSpace", "addressPrefixes")
+	var allErrs field.ErrorList
+	for _, ref := range r.peeringRefs() {
+		var peering VirtualNetworkPeering
+		key := client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+		if err := reader.Get(ctx, key, &peering); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return append(allErrs, field.InternalError(prefixesPath, fmt.Errorf("unable to get VirtualNetworkPeering %q with: %w", key, err)))
+		}
+
+		remote, err := findRemoteVirtualNetwork(ctx, reader, &peering)
+		if err != nil {
+			return append(allErrs, field.InternalError(prefixesPath, err))
+		}
+
+		if remote == nil {
+			continue
+		}
+
+		for _, overlap := range overlappingAddressSpace(r, remote) {
+			msg := fmt.Sprintf("overlaps with %s of VirtualNetwork %q, which is peered by VirtualNetworkPeering %q", overlap, objectKey(remote), objectKey(&peering))
+			allErrs = append(allErrs, field.Invalid(prefixesPath, r.Spec.Properties.AddressSpace.AddressPrefixes, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// findSubnetVirtualNetwork returns the VirtualNetwork which owns the subnet, or failing that, the VirtualNetwork in the
+// same namespace which references it. Nil is returned if there is neither.
+func findSubnetVirtualNetwork(ctx context.Context, reader client.Reader, subnet *Subnet) (*VirtualNetwork, error) {
+	return findOwningVirtualNetwork(ctx, reader, subnet, subnetRefsIndexKey, (*VirtualNetwork).subnetRefs)
+}
+
+// findOwningVirtualNetwork returns the VirtualNetwork which owns the child object, or failing that, the VirtualNetwork
+// in the same namespace with a reference to it among the given refs, which are indexed by indexKey. Nil is returned if
+// there is neither.
+func findOwningVirtualNetwork(ctx context.Context, reader client.Reader, child metav1.Object, indexKey string, refs func(*VirtualNetwork) []azcorev1.KnownTypeReference) (*VirtualNetwork, error) {
+	for _, ref := range child.GetOwnerReferences() {
+		if ref.Kind != "VirtualNetwork" || ownerGroup(ref.APIVersion) != GroupVersion.Group {
+			continue
+		}
+
+		var vnet VirtualNetwork
+		key := client.ObjectKey{Namespace: child.GetNamespace(), Name: ref.Name}
+		if err := reader.Get(ctx, key, &vnet); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("unable to get VirtualNetwork %q with: %w", key, err)
+		}
+		return &vnet, nil
+	}
+
+	var vnets VirtualNetworkList
+	childKey := client.ObjectKey{Namespace: child.GetNamespace(), Name: child.GetName()}.String()
+	if err := reader.List(ctx, &vnets, client.InNamespace(child.GetNamespace()), client.MatchingFields{indexKey: childKey}); err != nil {
+		return nil, fmt.Errorf("unable to list VirtualNetworks with: %w", err)
+	}
+
+	for i := range vnets.Items {
+		vnet := &vnets.Items[i]
+		for _, ref := range refs(vnet) {
+			if ref.Name == child.GetName() && ref.Namespace == child.GetNamespace() {
+				return vnet, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// findRemoteVirtualNetwork returns the VirtualNetwork the peering references, or for a peering by ARM ID, the
+// VirtualNetwork in any namespace which was provisioned with that ID. Nil is returned if there is neither.
+func findRemoteVirtualNetwork(ctx context.Context, reader client.Reader, peering *VirtualNetworkPeering) (*VirtualNetwork, error) {
+	props := peering.Spec.Properties
+	if props == nil {
+		return nil, nil
+	}
+
+	if ref := props.RemoteVirtualNetworkRef; ref != nil && ref.Name != "" {
+		var vnet VirtualNetwork
+		key := client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+		if key.Namespace == "" {
+			key.Namespace = peering.Namespace
+		}
+
+		if err := reader.Get(ctx, key, &vnet); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unable to get VirtualNetwork %q with: %w", key, err)
+		}
+		return &vnet, nil
+	}
+
+	if props.RemoteVirtualNetworkID == "" {
+		return nil, nil
+	}
+
+	var vnets VirtualNetworkList
+	if err := reader.List(ctx, &vnets, client.MatchingFields{azcorev1.ARMIDIndexKey: strings.ToLower(props.RemoteVirtualNetworkID)}); err != nil {
+		return nil, fmt.Errorf("unable to list VirtualNetworks with: %w", err)
+	}
+
+	for i := range vnets.Items {
+		if vnets.Items[i].Status.ID != "" && strings.EqualFold(vnets.Items[i].Status.ID, props.RemoteVirtualNetworkID) {
+			return &vnets.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// overlappingAddressSpace returns the ranges of the address space of other which overlap the address space of vnet
+func overlappingAddressSpace(vnet, other *VirtualNetwork) []*net.IPNet {
+	if vnet.Spec.Properties == nil || vnet.Spec.Properties.AddressSpace == nil ||
+		other.Spec.Properties == nil || other.Spec.Properties.AddressSpace == nil {
+		return nil
+	}
+
+	vnetNets := parseCIDRs(vnet.Spec.Properties.AddressSpace.AddressPrefixes)
+	var overlaps []*net.IPNet
+	for _, otherNet := range parseCIDRs(other.Spec.Properties.AddressSpace.AddressPrefixes) {
+		for _, vnetNet := range vnetNets {
+			if cidrsOverlap(vnetNet, otherNet) {
+				overlaps = append(overlaps, otherNet)
+				break
+			}
+		}
+	}
+	return overlaps
+}
+
+// subnetsOf returns the subnets referenced by the VirtualNetwork which exist
+func subnetsOf(ctx context.Context, reader client.Reader, vnet *VirtualNetwork) ([]Subnet, error) {
+	var subnets []Subnet
+	for _, ref := range vnet.subnetRefs() {
+		var subnet Subnet
+		key := client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+		if err := reader.Get(ctx, key, &subnet); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("unable to get Subnet %q with: %w", key, err)
+		}
+		subnets = append(subnets, subnet)
+	}
+
+	return subnets, nil
+}
+
+// subnetRefs returns the subnet references of the VirtualNetwork with the namespace of each defaulted
+func (r *VirtualNetwork) subnetRefs() []azcorev1.KnownTypeReference {
+	if r.Spec.Properties == nil {
+		return nil
+	}
+
+	return withDefaultNamespace(r.Spec.Properties.SubnetRefs, r.Namespace)
+}
+
+// peeringRefs returns the peering references of the VirtualNetwork with the namespace of each defaulted
+func (r *VirtualNetwork) peeringRefs() []azcorev1.KnownTypeReference {
+	if r.Spec.Properties == nil {
+		return nil
+	}
+
+	return withDefaultNamespace(r.Spec.Properties.VirtualNetworkPeeringRefs, r.Namespace)
+}
+
+func withDefaultNamespace(refs []azcorev1.KnownTypeReference, namespace string) []azcorev1.KnownTypeReference {
+	defaulted := make([]azcorev1.KnownTypeReference, len(refs))
+	for i, ref := range refs {
+		if ref.Namespace == "" {
+			ref.Namespace = namespace
+		}
+		defaulted[i] = ref
+	}
+	return defaulted
+}
+
+// RequiresAllocation returns true if the subnet requested a prefix length rather than specifying its prefixes, so a
+// prefix must be allocated from the address space of its VirtualNetwork
+func (r *Subnet) RequiresAllocation() bool {
+	return r.Spec.AddressPrefixLength > 0 && r.Spec.Properties.AddressPrefix == "" && len(r.Spec.Properties.AddressPrefixes) == 0
+}
+
+// AddressPrefixes returns the prefixes of the subnet, which are the allocated prefix if the subnet requires allocation
+func (r *Subnet) AddressPrefixes() []string {
+	if r.RequiresAllocation() {
+		if r.Status.AllocatedAddressPrefix == "" {
+			return nil
+		}
+		return []string{r.Status.AllocatedAddressPrefix}
+	}
+
+	prefixes, _ := r.Spec.Properties.prefixes(field.NewPath("spec", "properties"))
+	return prefixes
+}
+
+// prefixes returns addressPrefix and addressPrefixes as a single list, along with the path of each
+func (sp *SubnetProperties) prefixes(fldPath *field.Path) ([]string, []*field.Path) {
+	prefixes := sp.AddressPrefixes
+	paths := indexPaths(fldPath.Child("addressPrefixes"), len(sp.AddressPrefixes))
+	if sp.AddressPrefix != "" {
+		prefixes = append([]string{sp.AddressPrefix}, prefixes...)
+		paths = append([]*field.Path{fldPath.Child("addressPrefix")}, paths...)
+	}
+	return prefixes, paths
+}
+
+// parseCIDRs returns the prefixes which are valid CIDRs
+func parseCIDRs(prefixes []string) []*net.IPNet {
+	var ipNets []*net.IPNet
+	for _, prefix := range prefixes {
+		if _, ipNet, err := net.ParseCIDR(prefix); err == nil {
+			ipNets = append(ipNets, ipNet)
+		}
+	}
+	return ipNets
+}
+
+// withinAny returns true if the range is entirely contained by one of the others
+func withinAny(ipNet *net.IPNet, others []*net.IPNet) bool {
+	ones, bits := ipNet.Mask.Size()
+	for _, other := range others {
+		otherOnes, otherBits := other.Mask.Size()
+		if bits == otherBits && ones >= otherOnes && other.Contains(ipNet.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+func ownerGroup(apiVersion string) string {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return ""
+	}
+	return gv.Group
+}
+
+func objectKey(obj metav1.Object) string {
+	return client.ObjectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}.String()
+}
@@ -0,0 +1,243 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+func TestSubnet_ValidateCreate_AddressSpace(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	configureAddressSpaceReader(g)
+	defer azcorev1.ConfigureWebhooks(nil, types.NamespacedName{})
+
+	cases := []struct {
+		Name         string
+		Prefix       string
+		ExpectFields []string
+	}{
+		{
+			Name:   "Valid",
+			Prefix: "10.0.2.0/24",
+		},
+		{
+			Name:         "OutsideVirtualNetwork",
+			Prefix:       "10.1.0.0/24",
+			ExpectFields: []string{"spec.properties.addressPrefix"},
+		},
+		{
+			Name:         "OverlapsSibling",
+			Prefix:       "10.0.1.128/25",
+			ExpectFields: []string{"spec.properties.addressPrefix"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			subnet := &Subnet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "subnet-b"},
+				Spec: SubnetSpec{
+					APIVersion: "2019-11-01",
+					Properties: SubnetProperties{AddressPrefix: c.Prefix},
+				},
+			}
+
+			g := gomega.NewGomegaWithT(t)
+			expectInvalidFields(g, subnet.ValidateCreate(), c.ExpectFields)
+		})
+	}
+
+	// a subnet which no VirtualNetwork references is not checked
+	unreferenced := &Subnet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "subnet-c"},
+		Spec: SubnetSpec{
+			APIVersion: "2019-11-01",
+			Properties: SubnetProperties{AddressPrefix: "192.168.0.0/24"},
+		},
+	}
+	g.Expect(unreferenced.ValidateCreate()).To(gomega.Succeed())
+}
+
+func TestVirtualNetwork_ValidateUpdate_AddressSpace(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	old := configureAddressSpaceReader(g)
+	defer azcorev1.ConfigureWebhooks(nil, types.NamespacedName{})
+
+	vnet := old.DeepCopy()
+	vnet.Spec.Properties.AddressSpace.AddressPrefixes = []string{"10.0.0.0/16", "10.1.0.0/16"}
+	g.Expect(vnet.ValidateUpdate(old)).To(gomega.Succeed())
+
+	vnet.Spec.Properties.AddressSpace.AddressPrefixes = []string{"10.1.0.0/16"}
+	expectInvalidFields(g, vnet.ValidateUpdate(old), []string{"spec.properties.addressSpace.addressPrefixes"})
+}
+
+// configureAddressSpaceReader configures the webhooks with a VirtualNetwork referencing two subnets, only the first of
+// which exists, and returns the VirtualNetwork
+func configureAddressSpaceReader(g *gomega.GomegaWithT) *VirtualNetwork {
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(gomega.Succeed())
+
+	vnet := &VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "vnet"},
+		Spec: VirtualNetworkSpec{
+			APIVersion: "2019-11-01",
+			Properties: &VirtualNetworkSpecProperties{
+				AddressSpace: &AddressSpaceSpec{AddressPrefixes: []string{"10.0.0.0/16"}},
+				SubnetRefs: []azcorev1.KnownTypeReference{
+					{Name: "subnet-a"},
+					{Name: "subnet-b"},
+				},
+			},
+		},
+	}
+	subnet := &Subnet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "subnet-a"},
+		Spec: SubnetSpec{
+			APIVersion: "2019-11-01",
+			Properties: SubnetProperties{AddressPrefix: "10.0.1.0/24"},
+		},
+	}
+
+	azcorev1.ConfigureWebhooks(fake.NewFakeClientWithScheme(scheme, vnet.DeepCopy(), subnet), types.NamespacedName{})
+	return vnet
+}
+
+func TestVirtualNetworkPeering_ValidateCreate_AddressSpace(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	configurePeeringReader(g)
+	defer azcorev1.ConfigureWebhooks(nil, types.NamespacedName{})
+
+	cases := []struct {
+		Name         string
+		Properties   VirtualNetworkPeeringSpecProperties
+		ExpectFields []string
+	}{
+		{
+			Name:       "RefInOtherNamespace",
+			Properties: VirtualNetworkPeeringSpecProperties{RemoteVirtualNetworkRef: &azcorev1.KnownTypeReference{Name: "hub", Namespace: "hub"}},
+		},
+		{
+			Name:         "RefOverlaps",
+			Properties:   VirtualNetworkPeeringSpecProperties{RemoteVirtualNetworkRef: &azcorev1.KnownTypeReference{Name: "overlapping"}},
+			ExpectFields: []string{"spec.properties.remoteVirtualNetworkRef"},
+		},
+		{
+			Name:         "IDOfProvisionedVirtualNetworkOverlaps",
+			Properties:   VirtualNetworkPeeringSpecProperties{RemoteVirtualNetworkID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/OVERLAPPING"},
+			ExpectFields: []string{"spec.properties.remoteVirtualNetworkId"},
+		},
+		{
+			Name:       "IDOfUnknownVirtualNetwork",
+			Properties: VirtualNetworkPeeringSpecProperties{RemoteVirtualNetworkID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/external"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			peering := &VirtualNetworkPeering{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "spoke-to-hub"},
+				Spec: VirtualNetworkPeeringSpec{
+					APIVersion: "2019-11-01",
+					Properties: &c.Properties,
+				},
+			}
+
+			g := gomega.NewGomegaWithT(t)
+			expectInvalidFields(g, peering.ValidateCreate(), c.ExpectFields)
+		})
+	}
+}
+
+func TestVirtualNetwork_ValidateUpdate_PeeredAddressSpace(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	old := configurePeeringReader(g)
+	defer azcorev1.ConfigureWebhooks(nil, types.NamespacedName{})
+
+	vnet := old.DeepCopy()
+	vnet.Spec.Properties.AddressSpace.AddressPrefixes = []string{"10.0.0.0/16", "10.2.0.0/16"}
+	g.Expect(vnet.ValidateUpdate(old)).To(gomega.Succeed())
+
+	vnet.Spec.Properties.AddressSpace.AddressPrefixes = []string{"10.0.0.0/16", "10.1.0.0/24"}
+	expectInvalidFields(g, vnet.ValidateUpdate(old), []string{"spec.properties.addressSpace.addressPrefixes"})
+}
+
+// configurePeeringReader configures the webhooks with a spoke VirtualNetwork peered with a hub VirtualNetwork in
+// another namespace, along with a provisioned VirtualNetwork which overlaps the spoke, and returns the spoke
+func configurePeeringReader(g *gomega.GomegaWithT) *VirtualNetwork {
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(gomega.Succeed())
+
+	newVNet := func(namespace, name, prefix string) *VirtualNetwork {
+		return &VirtualNetwork{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: VirtualNetworkSpec{
+				APIVersion: "2019-11-01",
+				Properties: &VirtualNetworkSpecProperties{
+					AddressSpace: &AddressSpaceSpec{AddressPrefixes: []string{prefix}},
+				},
+			},
+		}
+	}
+
+	spoke := newVNet("default", "spoke", "10.0.0.0/16")
+	spoke.Spec.Properties.VirtualNetworkPeeringRefs = []azcorev1.KnownTypeReference{{Name: "spoke-to-hub"}}
+	hub := newVNet("hub", "hub", "10.1.0.0/16")
+	overlapping := newVNet("default", "overlapping", "10.0.128.0/17")
+	overlapping.Status.ID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/overlapping"
+	peering := &VirtualNetworkPeering{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "spoke-to-hub"},
+		Spec: VirtualNetworkPeeringSpec{
+			APIVersion: "2019-11-01",
+			Properties: &VirtualNetworkPeeringSpecProperties{
+				RemoteVirtualNetworkRef: &azcorev1.KnownTypeReference{Name: "hub", Namespace: "hub"},
+			},
+		},
+	}
+
+	azcorev1.ConfigureWebhooks(fake.NewFakeClientWithScheme(scheme, spoke.DeepCopy(), hub, overlapping, peering), types.NamespacedName{})
+	return spoke
+}
+
+type recordingIndexer map[string]client.IndexerFunc
+
+func (ri recordingIndexer) IndexField(_ runtime.Object, field string, extractValue client.IndexerFunc) error {
+	ri[field] = extractValue
+	return nil
+}
+
+func TestVirtualNetwork_IndexFields(t *testing.T) {
+	vnet := &VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "vnet"},
+		Spec: VirtualNetworkSpec{
+			Properties: &VirtualNetworkSpecProperties{
+				SubnetRefs: []azcorev1.KnownTypeReference{
+					{Name: "subnet-a"},
+					{Namespace: "other", Name: "subnet-b"},
+				},
+				VirtualNetworkPeeringRefs: []azcorev1.KnownTypeReference{
+					{Name: "peering"},
+				},
+			},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	indexer := recordingIndexer{}
+	g.Expect(vnet.IndexFields(indexer)).To(gomega.Succeed())
+	g.Expect(indexer[subnetRefsIndexKey](vnet)).To(gomega.Equal([]string{"default/subnet-a", "other/subnet-b"}))
+	g.Expect(indexer[peeringRefsIndexKey](vnet)).To(gomega.Equal([]string{"default/peering"}))
+}
@@ -0,0 +1,31 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"github.com/Azure/k8s-infra/pkg/zips"
+)
+
+// The ToARM and FromARM conversions of the types of this group are generated into zz_generated.arm.go from the arm
+// tags of their fields. The hooks below cover what the tags can not describe.
+
+// customizeARMResource places the private DNS zone in the global location, as private DNS zones are not regional
+func (zone *PrivateDNSZone) customizeARMResource(res *zips.Resource) {
+	res.Location = privateDNSLocation
+}
+
+// customizeARMResource places the virtual network link in the global location of its private DNS zone
+func (link *VirtualNetworkLink) customizeARMResource(res *zips.Resource) {
+	res.Location = privateDNSLocation
+}
+
+// customizeARMProperties sends the prefix allocated from the address space of the VirtualNetwork when the subnet
+// requested a prefix length rather than specifying its address prefix
+func (r *Subnet) customizeARMProperties(props *SubnetProperties) {
+	if r.RequiresAllocation() {
+		props.AddressPrefix = r.Status.AllocatedAddressPrefix
+	}
+}
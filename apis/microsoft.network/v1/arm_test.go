@@ -0,0 +1,114 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	v1 "github.com/Azure/k8s-infra/apis/core/v1"
+	"github.com/Azure/k8s-infra/pkg/zips"
+)
+
+type fakeResolver map[string]string
+
+func (f fakeResolver) ResolveARMID(_ context.Context, ref v1.KnownTypeReference, _, kind string) (string, error) {
+	return f[kind+"/"+ref.Name], nil
+}
+
+func TestSubnet_FromARM(t *testing.T) {
+	res := &zips.Resource{
+		ID:                "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet",
+		ETag:              `W/"etag"`,
+		ProvisioningState: zips.SucceededProvisioningState,
+		Properties:        []byte(`{"addressPrefix":"10.0.0.0/24","ipConfigurations":[{"id":"ipconfig1"}],"provisioningState":"Succeeded"}`),
+	}
+
+	var subnet Subnet
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(subnet.FromARM(res)).To(gomega.Succeed())
+	g.Expect(subnet.Status.ID).To(gomega.Equal(res.ID))
+	g.Expect(subnet.Status.ETag).To(gomega.Equal(res.ETag))
+	g.Expect(subnet.Status.IPConfigurations).To(gomega.Equal([]v1.ARMIDReference{{ID: "ipconfig1"}}))
+}
+
+func TestVirtualNetwork_FromARM_IgnoresPropertiesUntilProvisioned(t *testing.T) {
+	vnet := VirtualNetwork{
+		Status: VirtualNetworkStatus{
+			ResourceGUID: "guid",
+		},
+	}
+
+	res := &zips.Resource{
+		ProvisioningState: zips.AcceptedProvisioningState,
+		Properties:        []byte(`{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}`),
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(vnet.FromARM(res)).To(gomega.Succeed())
+	g.Expect(vnet.Status.ProvisioningState).To(gomega.Equal(string(zips.AcceptedProvisioningState)))
+	g.Expect(vnet.Status.ResourceGUID).To(gomega.Equal("guid"))
+}
+
+func TestPublicIPAddress_FromARM(t *testing.T) {
+	res := &zips.Resource{
+		ID:                "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/pip",
+		ProvisioningState: zips.SucceededProvisioningState,
+		Properties:        []byte(`{"dnsSettings":{"domainNameLabel":"app","fqdn":"app.westus2.cloudapp.azure.com"},"ipAddress":"20.0.0.1"}`),
+	}
+
+	var pip PublicIPAddress
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(pip.FromARM(res)).To(gomega.Succeed())
+	g.Expect(pip.Status.IPAddress).To(gomega.Equal("20.0.0.1"))
+	g.Expect(pip.Status.FQDN).To(gomega.Equal("app.westus2.cloudapp.azure.com"))
+}
+
+func TestVirtualNetworkPeering_ToARM(t *testing.T) {
+	peering := &VirtualNetworkPeering{
+		Spec: VirtualNetworkPeeringSpec{
+			APIVersion: "2019-11-01",
+			Properties: &VirtualNetworkPeeringSpecProperties{
+				AllowForwardedTraffic:   true,
+				RemoteVirtualNetworkRef: &v1.KnownTypeReference{Name: "hub"},
+			},
+		},
+	}
+
+	// the remote virtual network is required, so the peering can not be applied until it has been provisioned
+	g := gomega.NewGomegaWithT(t)
+	_, err := peering.ToARM(context.Background(), fakeResolver{})
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	res, err := peering.ToARM(context.Background(), fakeResolver{"VirtualNetwork/hub": "/vnets/hub"})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(res.Type).To(gomega.Equal("Microsoft.Network/virtualNetworks/virtualNetworkPeerings"))
+	g.Expect(string(res.Properties)).To(gomega.MatchJSON(`{"allowForwardedTraffic":true,"remoteVirtualNetwork":{"id":"/vnets/hub"}}`))
+}
+
+func TestVirtualNetworkLink_ToARM(t *testing.T) {
+	link := &VirtualNetworkLink{
+		Spec: VirtualNetworkLinkSpec{
+			APIVersion: "2018-09-01",
+			Properties: &VirtualNetworkLinkSpecProperties{
+				VirtualNetworkID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+			},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	res, err := link.ToARM(context.Background(), fakeResolver{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(res.Location).To(gomega.Equal("global"))
+
+	var props map[string]interface{}
+	g.Expect(json.Unmarshal(res.Properties, &props)).To(gomega.Succeed())
+	g.Expect(props).To(gomega.HaveKeyWithValue("registrationEnabled", false))
+	g.Expect(props).To(gomega.HaveKeyWithValue("virtualNetwork", map[string]interface{}{"id": link.Spec.Properties.VirtualNetworkID}))
+}
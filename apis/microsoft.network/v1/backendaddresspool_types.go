@@ -13,9 +13,9 @@ import (
 
 type (
 	BackendAddressPoolSpecProperties struct {
-		BackendIPConfigurationRefs []azcorev1.KnownTypeReference `json:"backendIPConfigurations,omitempty" group:"microsoft.network.infra.azure.com" kind:"NetworkInterfaceIPConfiguration"`
-		LoadBalancingRuleRefs      []azcorev1.KnownTypeReference `json:"loadBalancingRuleRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"LoadBalancingRule"`
-		OutboundRuleRefs           []azcorev1.KnownTypeReference `json:"outboundRuleRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"OutboundRule"`
+		BackendIPConfigurationRefs []azcorev1.KnownTypeReference `json:"backendIPConfigurations,omitempty" group:"microsoft.network.infra.azure.com" kind:"NetworkInterfaceIPConfiguration" arm:"backendIPConfigurations"`
+		LoadBalancingRuleRefs      []azcorev1.KnownTypeReference `json:"loadBalancingRuleRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"LoadBalancingRule" arm:"loadBalancingRules"`
+		OutboundRuleRefs           []azcorev1.KnownTypeReference `json:"outboundRuleRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"OutboundRule" arm:"outboundRules"`
 	}
 
 	BackendAddressPoolSpec struct {
@@ -23,6 +23,10 @@ type (
 		APIVersion string `json:"apiVersion"`
 
 		Properties *BackendAddressPoolSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// BackendAddressPoolStatus defines the observed state of BackendAddressPool
@@ -31,6 +35,12 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
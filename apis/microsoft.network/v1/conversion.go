@@ -10,11 +10,16 @@ func (*FrontendIPConfiguration) Hub()         {}
 func (*InboundNatRule) Hub()                  {}
 func (*LoadBalancer) Hub()                    {}
 func (*LoadBalancingRule) Hub()               {}
+func (*NetworkInterface) Hub()                {}
 func (*NetworkInterfaceIPConfiguration) Hub() {}
 func (*NetworkSecurityGroup) Hub()            {}
 func (*OutboundRule) Hub()                    {}
+func (*PrivateDNSZone) Hub()                  {}
+func (*PublicIPAddress) Hub()                 {}
 func (*Route) Hub()                           {}
 func (*RouteTable) Hub()                      {}
 func (*SecurityRule) Hub()                    {}
 func (*VirtualNetwork) Hub()                  {}
+func (*VirtualNetworkLink) Hub()              {}
+func (*VirtualNetworkPeering) Hub()           {}
 func (*Subnet) Hub()                          {}
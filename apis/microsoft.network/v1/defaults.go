@@ -0,0 +1,18 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+const (
+	// defaultAPIVersion is the Microsoft.Network API version of the newest version of the group
+	defaultAPIVersion = "2019-11-01"
+
+	// defaultPrivateDNSAPIVersion is the API version of private DNS zones, which are versioned separately from the
+	// rest of Microsoft.Network
+	defaultPrivateDNSAPIVersion = "2018-09-01"
+
+	// privateDNSLocation is the location of every private DNS zone and virtual network link
+	privateDNSLocation = "global"
+)
@@ -0,0 +1,86 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	microsoftresourcesv1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
+)
+
+func TestVirtualNetwork_Default(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(microsoftresourcesv1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	tagsKey := types.NamespacedName{Namespace: "k8s-infra-system", Name: "default-tags"}
+	reader := fake.NewFakeClientWithScheme(scheme,
+		&microsoftresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rg"},
+			Spec:       microsoftresourcesv1.ResourceGroupSpec{Location: "westus2"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: tagsKey.Namespace, Name: tagsKey.Name},
+			Data:       map[string]string{"costCenter": "1234", "env": "prod"},
+		},
+	)
+
+	azcorev1.ConfigureWebhooks(reader, tagsKey)
+	defer azcorev1.ConfigureWebhooks(nil, types.NamespacedName{})
+
+	vnet := &VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "vnet"},
+		Spec: VirtualNetworkSpec{
+			ResourceGroupRef: &azcorev1.KnownTypeReference{Name: "rg"},
+			Tags:             map[string]string{"env": "test"},
+		},
+	}
+	vnet.Default()
+
+	g.Expect(vnet.Spec.APIVersion).To(gomega.Equal(defaultAPIVersion))
+	g.Expect(vnet.Spec.Location).To(gomega.Equal("westus2"))
+	g.Expect(vnet.Spec.ResourceGroupRef.Namespace).To(gomega.Equal("default"))
+	g.Expect(vnet.Spec.Tags).To(gomega.Equal(map[string]string{"costCenter": "1234", "env": "test"}))
+
+	// a location already set is left alone
+	vnet.Spec.Location = "eastus"
+	vnet.Default()
+	g.Expect(vnet.Spec.Location).To(gomega.Equal("eastus"))
+}
+
+func TestLoadBalancer_Default_ReferenceNamespaces(t *testing.T) {
+	lb := &LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "lb"},
+		Spec: LoadBalancerSpec{
+			APIVersion: "2019-11-01",
+			Location:   "westus2",
+			Properties: &LoadBalancerSpecProperties{
+				FrontendIPConfigurationRefs: []azcorev1.KnownTypeReference{
+					{Name: "fipc"},
+					{Name: "other-fipc", Namespace: "other"},
+				},
+			},
+		},
+	}
+	lb.Default()
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(lb.Spec.Properties.FrontendIPConfigurationRefs).To(gomega.Equal([]azcorev1.KnownTypeReference{
+		{Name: "fipc", Namespace: "default"},
+		{Name: "other-fipc", Namespace: "other"},
+	}))
+}
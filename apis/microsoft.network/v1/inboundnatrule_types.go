@@ -7,6 +7,8 @@ package v1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
 
 type (
@@ -27,6 +29,10 @@ type (
 
 		// Properties of the Virtual Network
 		Properties *InboundNatRuleSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// InboundNatRuleStatus defines the observed state of InboundNatRule
@@ -35,6 +41,12 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
@@ -13,10 +13,10 @@ import (
 
 type (
 	LoadBalancerSpecProperties struct {
-		BackendAddressPoolRefs      []azcorev1.KnownTypeReference `json:"backendAddressPools,omitempty" group:"microsoft.network.infra.azure.com" kind:"BackendAddressPool" owned:"true"`
-		FrontendIPConfigurationRefs []azcorev1.KnownTypeReference `json:"frontendIPConfigurationRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"FrontendIPConfiguration" owned:"true"`
-		InboundNatRuleRefs          []azcorev1.KnownTypeReference `json:"inboundNatPoolRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"InboundNatRule" owned:"true"`
-		LoadBalancingRuleRefs       []azcorev1.KnownTypeReference `json:"loadBalancingRuleRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"LoadBalancingRule" owned:"true"`
+		BackendAddressPoolRefs      []azcorev1.KnownTypeReference `json:"backendAddressPools,omitempty" group:"microsoft.network.infra.azure.com" kind:"BackendAddressPool" owned:"true" arm:"backendAddressPools"`
+		FrontendIPConfigurationRefs []azcorev1.KnownTypeReference `json:"frontendIPConfigurationRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"FrontendIPConfiguration" owned:"true" arm:"frontendIPConfigurations"`
+		InboundNatRuleRefs          []azcorev1.KnownTypeReference `json:"inboundNatPoolRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"InboundNatRule" owned:"true" arm:"inboundNatRules"`
+		LoadBalancingRuleRefs       []azcorev1.KnownTypeReference `json:"loadBalancingRuleRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"LoadBalancingRule" owned:"true" arm:"loadBalancingRules"`
 	}
 
 	// LoadBalancerSpec defines the desired state of LoadBalancer
@@ -25,14 +25,14 @@ type (
 		APIVersion string `json:"apiVersion"`
 		// ResourceGroupRef is the Azure Resource Group the VirtualNetwork resides within
 		// +kubebuilder:validation:Required
-		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup"`
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
 
 		// Location of the VNET in Azure
 		// +kubebuilder:validation:Required
-		Location string `json:"location"`
+		Location string `json:"location" immutable:"true"`
 
 		// +kubebuilder:validation:Enum=Basic;Standard
-		SKU string `json:"sku,omitempty"`
+		SKU string `json:"sku,omitempty" immutable:"true"`
 
 		// Tags are user defined key value pairs
 		// +optional
@@ -40,6 +40,10 @@ type (
 
 		// Properties of the Virtual Network
 		Properties *LoadBalancerSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// LoadBalancerStatus defines the observed state of LoadBalancer
@@ -48,6 +52,15 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// ResourceGUID is the unique identifier Azure assigned to the load balancer
+		// +k8s:conversion-gen=false
+		ResourceGUID string `json:"resourceGuid,omitempty" arm:"resourceGuid"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
@@ -18,7 +18,7 @@ type (
 		EnableFloatingIP           bool                         `json:"enableFloatingIP,omitempty"`
 		EnableTCPReset             bool                         `json:"enableTCPReset,omitempty"`
 		FrontendPort               int                          `json:"frontendPort,omitempty"`
-		FrontendIPConfigurationRef *azcorev1.KnownTypeReference `json:"frontendIPConfigurationRef,omitempty" group:"microsoft.network.infra.azure.com" kind:"NetworkInterfaceIPConfiguration"`
+		FrontendIPConfigurationRef *azcorev1.KnownTypeReference `json:"frontendIPConfigurationRef,omitempty" group:"microsoft.network.infra.azure.com" kind:"NetworkInterfaceIPConfiguration" arm:"frontendIPConfiguration"`
 		IdleTimeoutInMinutes       int                          `json:"idleTimeoutInMinutes,omitempty"`
 		// +kubebuilder:validation:Enum=Default;SourceIP;SourceIPProtocol
 		LoadDistribution string `json:"loadDistribution,omitempty"`
@@ -31,6 +31,10 @@ type (
 		// +k8s:conversion-gen=false
 		APIVersion string                          `json:"apiVersion,omitempty"`
 		Properties *LoadBalancerRuleSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// LoadBalancingRuleStatus defines the observed state of LoadBalancingRule
@@ -39,6 +43,12 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
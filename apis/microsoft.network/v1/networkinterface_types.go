@@ -0,0 +1,101 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// NetworkInterfaceDNSSettings are the DNS settings of the network interface
+	NetworkInterfaceDNSSettings struct {
+		DNSServers           []string `json:"dnsServers,omitempty"`
+		InternalDNSNameLabel string   `json:"internalDnsNameLabel,omitempty"`
+	}
+
+	// NetworkInterfaceSpecProperties are the resource specific properties
+	NetworkInterfaceSpecProperties struct {
+		DNSSettings                 *NetworkInterfaceDNSSettings  `json:"dnsSettings,omitempty"`
+		EnableAcceleratedNetworking bool                          `json:"enableAcceleratedNetworking,omitempty"`
+		EnableIPForwarding          bool                          `json:"enableIPForwarding,omitempty"`
+		IPConfigurationRefs         []azcorev1.KnownTypeReference `json:"ipConfigurationRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"NetworkInterfaceIPConfiguration" owned:"true" arm:"ipConfigurations"`
+		NetworkSecurityGroupRef     *azcorev1.KnownTypeReference  `json:"networkSecurityGroupRef,omitempty" group:"microsoft.network.infra.azure.com" kind:"NetworkSecurityGroup" arm:"networkSecurityGroup"`
+	}
+
+	// NetworkInterfaceSpec defines the desired state of NetworkInterface
+	NetworkInterfaceSpec struct {
+		// +k8s:conversion-gen=false
+		APIVersion string `json:"apiVersion"`
+
+		// ResourceGroupRef is the Azure Resource Group the NetworkInterface resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
+
+		// Location of the NetworkInterface in Azure, which must be the location of the VirtualNetwork of its subnets
+		// +kubebuilder:validation:Required
+		Location string `json:"location" immutable:"true"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the NetworkInterface
+		Properties *NetworkInterfaceSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// NetworkInterfaceStatus defines the observed state of NetworkInterface
+	NetworkInterfaceStatus struct {
+		ID string `json:"id,omitempty"`
+		// +k8s:conversion-gen=false
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// MACAddress is the MAC address Azure assigned to the network interface once it was attached to a virtual
+		// machine
+		// +k8s:conversion-gen=false
+		MACAddress string `json:"macAddress,omitempty" arm:"macAddress"`
+		// VirtualMachine is the virtual machine the network interface is attached to
+		// +k8s:conversion-gen=false
+		VirtualMachine *azcorev1.ARMIDReference `json:"virtualMachine,omitempty" arm:"virtualMachine"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+	// +kubebuilder:subresource:status
+	// +kubebuilder:storageversion
+
+	// NetworkInterface is the Schema for the networkinterfaces API
+	NetworkInterface struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   NetworkInterfaceSpec   `json:"spec,omitempty"`
+		Status NetworkInterfaceStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// NetworkInterfaceList contains a list of NetworkInterface
+	NetworkInterfaceList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []NetworkInterface `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&NetworkInterface{}, &NetworkInterfaceList{})
+}
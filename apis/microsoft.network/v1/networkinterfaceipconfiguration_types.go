@@ -19,8 +19,8 @@ type (
 		PrivateIPAddressVersion string `json:"privateIPAddressVersion,omitempty"`
 		// +kubebuilder:validation:Enum=Dynamic;Static
 		PrivateIPAllocationMethod string                       `json:"privateIPAllocationMethod,omitempty"`
-		PublicIPAddressRef        *azcorev1.KnownTypeReference `json:"publicIPAddressRef,omitempty" group:"microsoft.network.infra.azure.com" kind:"PublicIPAddress"`
-		SubnetRef                 *azcorev1.KnownTypeReference `json:"subnetRef,omitempty" group:"microsoft.network.infra.azure.com" kind:"Subnet"`
+		PublicIPAddressRef        *azcorev1.KnownTypeReference `json:"publicIPAddressRef,omitempty" group:"microsoft.network.infra.azure.com" kind:"PublicIPAddress" arm:"publicIPAddress"`
+		SubnetRef                 *azcorev1.KnownTypeReference `json:"subnetRef,omitempty" group:"microsoft.network.infra.azure.com" kind:"Subnet" arm:"subnet"`
 	}
 
 	// NetworkInterfaceIPConfigurationSpec defines the desired state of NetworkInterfaceIPConfiguration
@@ -28,6 +28,10 @@ type (
 		// +k8s:conversion-gen=false
 		APIVersion string                                         `json:"apiVersion,omitempty"`
 		Properties *NetworkInterfaceIPConfigurationSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// NetworkInterfaceIPConfigurationStatus defines the observed state of NetworkInterfaceIPConfiguration
@@ -36,6 +40,15 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// PrivateIPAddress is the private IP address allocated by Azure
+		// +k8s:conversion-gen=false
+		PrivateIPAddress string `json:"privateIPAddress,omitempty" arm:"privateIPAddress"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
@@ -14,7 +14,7 @@ import (
 // NetworkSecurityGroupSpec defines the desired state of NetworkSecurityGroup
 type (
 	NetworkSecurityGroupSpecProperties struct {
-		SecurityRuleRefs []azcorev1.KnownTypeReference `json:"securityRules,omitempty" group:"microsoft.network.infra.azure.com" kind:"SecurityRule" owned:"true"`
+		SecurityRuleRefs []azcorev1.KnownTypeReference `json:"securityRules,omitempty" group:"microsoft.network.infra.azure.com" kind:"SecurityRule" owned:"true" arm:"securityRules"`
 	}
 
 	NetworkSecurityGroupSpec struct {
@@ -23,11 +23,11 @@ type (
 
 		// ResourceGroupRef is the Azure Resource Group the VirtualNetwork resides within
 		// +kubebuilder:validation:Required
-		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup"`
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
 
 		// Location of the VNET in Azure
 		// +kubebuilder:validation:Required
-		Location string `json:"location"`
+		Location string `json:"location" immutable:"true"`
 
 		// Tags are user defined key value pairs
 		// +optional
@@ -35,6 +35,10 @@ type (
 
 		// Properties of the Virtual Network
 		Properties *NetworkSecurityGroupSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// NetworkSecurityGroupStatus defines the observed state of NetworkSecurityGroup
@@ -43,6 +47,15 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// ResourceGUID is the unique identifier Azure assigned to the network security group
+		// +k8s:conversion-gen=false
+		ResourceGUID string `json:"resourceGuid,omitempty" arm:"resourceGuid"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
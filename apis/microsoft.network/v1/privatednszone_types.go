@@ -0,0 +1,83 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// PrivateDNSZoneSpecProperties are the resource specific properties
+	PrivateDNSZoneSpecProperties struct {
+		// VirtualNetworkLinkRefs are the links of the zone to the virtual networks which resolve its records
+		// +optional
+		VirtualNetworkLinkRefs []azcorev1.KnownTypeReference `json:"virtualNetworkLinkRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"VirtualNetworkLink" owned:"true" arm:"-"`
+	}
+
+	// PrivateDNSZoneSpec defines the desired state of PrivateDNSZone. The name of the object is the name of the zone,
+	// eg. privatelink.blob.core.windows.net, and the zone is always in the global location.
+	PrivateDNSZoneSpec struct {
+		// +k8s:conversion-gen=false
+		APIVersion string `json:"apiVersion"`
+
+		// ResourceGroupRef is the Azure Resource Group the PrivateDNSZone resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the PrivateDNSZone
+		Properties *PrivateDNSZoneSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// PrivateDNSZoneStatus defines the observed state of PrivateDNSZone
+	PrivateDNSZoneStatus struct {
+		ID string `json:"id,omitempty"`
+		// +k8s:conversion-gen=false
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+	// +kubebuilder:subresource:status
+	// +kubebuilder:storageversion
+
+	// PrivateDNSZone is the Schema for the privatednszones API
+	PrivateDNSZone struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   PrivateDNSZoneSpec   `json:"spec,omitempty"`
+		Status PrivateDNSZoneStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// PrivateDNSZoneList contains a list of PrivateDNSZone
+	PrivateDNSZoneList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []PrivateDNSZone `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&PrivateDNSZone{}, &PrivateDNSZoneList{})
+}
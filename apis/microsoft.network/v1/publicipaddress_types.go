@@ -0,0 +1,109 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// PublicIPAddressDNSSettings are the DNS settings of the public IP address
+	PublicIPAddressDNSSettings struct {
+		DomainNameLabel string `json:"domainNameLabel,omitempty"`
+		ReverseFQDN     string `json:"reverseFqdn,omitempty"`
+	}
+
+	// PublicIPAddressSpecProperties are the resource specific properties
+	PublicIPAddressSpecProperties struct {
+		DNSSettings          *PublicIPAddressDNSSettings `json:"dnsSettings,omitempty"`
+		IdleTimeoutInMinutes int                         `json:"idleTimeoutInMinutes,omitempty"`
+		// +kubebuilder:validation:Enum=IPv4;IPv6
+		PublicIPAddressVersion string `json:"publicIPAddressVersion,omitempty" immutable:"true"`
+		// +kubebuilder:validation:Enum=Dynamic;Static
+		PublicIPAllocationMethod string `json:"publicIPAllocationMethod,omitempty"`
+	}
+
+	// PublicIPAddressSpec defines the desired state of PublicIPAddress
+	PublicIPAddressSpec struct {
+		// +k8s:conversion-gen=false
+		APIVersion string `json:"apiVersion"`
+
+		// ResourceGroupRef is the Azure Resource Group the PublicIPAddress resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
+
+		// Location of the PublicIPAddress in Azure
+		// +kubebuilder:validation:Required
+		Location string `json:"location" immutable:"true"`
+
+		// SKU of the PublicIPAddress, which must match the SKU of any LoadBalancer it is used by
+		// +kubebuilder:validation:Enum=Basic;Standard
+		SKU string `json:"sku,omitempty" immutable:"true"`
+
+		// Zones the PublicIPAddress is allocated within
+		// +optional
+		Zones []string `json:"zones,omitempty" immutable:"true"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the PublicIPAddress
+		Properties *PublicIPAddressSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// PublicIPAddressStatus defines the observed state of PublicIPAddress
+	PublicIPAddressStatus struct {
+		ID string `json:"id,omitempty"`
+		// +k8s:conversion-gen=false
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// IPAddress is the public IP address allocated by Azure
+		// +k8s:conversion-gen=false
+		IPAddress string `json:"ipAddress,omitempty" arm:"ipAddress"`
+		// FQDN is the fully qualified domain name of the DNS record for the domain name label
+		// +k8s:conversion-gen=false
+		FQDN string `json:"fqdn,omitempty" arm:"dnsSettings.fqdn"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+	// +kubebuilder:subresource:status
+	// +kubebuilder:storageversion
+
+	// PublicIPAddress is the Schema for the publicipaddresses API
+	PublicIPAddress struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   PublicIPAddressSpec   `json:"spec,omitempty"`
+		Status PublicIPAddressStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// PublicIPAddressList contains a list of PublicIPAddress
+	PublicIPAddressList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []PublicIPAddress `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&PublicIPAddress{}, &PublicIPAddressList{})
+}
@@ -25,6 +25,18 @@ func (nsg *NetworkSecurityGroup) GetResourceGroupObjectRef() *azcorev1.KnownType
 	return nsg.Spec.ResourceGroupRef
 }
 
+func (nic *NetworkInterface) GetResourceGroupObjectRef() *azcorev1.KnownTypeReference {
+	return nic.Spec.ResourceGroupRef
+}
+
+func (pip *PublicIPAddress) GetResourceGroupObjectRef() *azcorev1.KnownTypeReference {
+	return pip.Spec.ResourceGroupRef
+}
+
+func (zone *PrivateDNSZone) GetResourceGroupObjectRef() *azcorev1.KnownTypeReference {
+	return zone.Spec.ResourceGroupRef
+}
+
 func (*VirtualNetwork) ResourceType() string {
 	return "Microsoft.Network/virtualNetworks"
 }
@@ -72,3 +84,243 @@ func (*SecurityRule) ResourceType() string {
 func (*Subnet) ResourceType() string {
 	return "Microsoft.Network/virtualNetworks/subnets"
 }
+
+func (*NetworkInterface) ResourceType() string {
+	return "Microsoft.Network/networkInterfaces"
+}
+
+func (*NetworkInterfaceIPConfiguration) ResourceType() string {
+	return "Microsoft.Network/networkInterfaces/ipConfigurations"
+}
+
+func (*PublicIPAddress) ResourceType() string {
+	return "Microsoft.Network/publicIPAddresses"
+}
+
+func (*VirtualNetworkPeering) ResourceType() string {
+	return "Microsoft.Network/virtualNetworks/virtualNetworkPeerings"
+}
+
+func (*PrivateDNSZone) ResourceType() string {
+	return "Microsoft.Network/privateDnsZones"
+}
+
+func (*VirtualNetworkLink) ResourceType() string {
+	return "Microsoft.Network/privateDnsZones/virtualNetworkLinks"
+}
+
+func (bap *BackendAddressPool) GetOutputs() *azcorev1.OutputsSpec {
+	return bap.Spec.Outputs
+}
+
+func (fipc *FrontendIPConfiguration) GetOutputs() *azcorev1.OutputsSpec {
+	return fipc.Spec.Outputs
+}
+
+func (inr *InboundNatRule) GetOutputs() *azcorev1.OutputsSpec {
+	return inr.Spec.Outputs
+}
+
+func (lb *LoadBalancer) GetOutputs() *azcorev1.OutputsSpec {
+	return lb.Spec.Outputs
+}
+
+func (lbr *LoadBalancingRule) GetOutputs() *azcorev1.OutputsSpec {
+	return lbr.Spec.Outputs
+}
+
+func (nic *NetworkInterface) GetOutputs() *azcorev1.OutputsSpec {
+	return nic.Spec.Outputs
+}
+
+func (ipc *NetworkInterfaceIPConfiguration) GetOutputs() *azcorev1.OutputsSpec {
+	return ipc.Spec.Outputs
+}
+
+func (nsg *NetworkSecurityGroup) GetOutputs() *azcorev1.OutputsSpec {
+	return nsg.Spec.Outputs
+}
+
+func (or *OutboundRule) GetOutputs() *azcorev1.OutputsSpec {
+	return or.Spec.Outputs
+}
+
+func (zone *PrivateDNSZone) GetOutputs() *azcorev1.OutputsSpec {
+	return zone.Spec.Outputs
+}
+
+func (pip *PublicIPAddress) GetOutputs() *azcorev1.OutputsSpec {
+	return pip.Spec.Outputs
+}
+
+func (r *Route) GetOutputs() *azcorev1.OutputsSpec {
+	return r.Spec.Outputs
+}
+
+func (rt *RouteTable) GetOutputs() *azcorev1.OutputsSpec {
+	return rt.Spec.Outputs
+}
+
+func (sr *SecurityRule) GetOutputs() *azcorev1.OutputsSpec {
+	return sr.Spec.Outputs
+}
+
+func (s *Subnet) GetOutputs() *azcorev1.OutputsSpec {
+	return s.Spec.Outputs
+}
+
+func (vnet *VirtualNetwork) GetOutputs() *azcorev1.OutputsSpec {
+	return vnet.Spec.Outputs
+}
+
+func (link *VirtualNetworkLink) GetOutputs() *azcorev1.OutputsSpec {
+	return link.Spec.Outputs
+}
+
+func (peering *VirtualNetworkPeering) GetOutputs() *azcorev1.OutputsSpec {
+	return peering.Spec.Outputs
+}
+
+func (bap *BackendAddressPool) GetConditions() azcorev1.Conditions {
+	return bap.Status.Conditions
+}
+
+func (bap *BackendAddressPool) SetConditions(conditions azcorev1.Conditions) {
+	bap.Status.Conditions = conditions
+}
+
+func (fipc *FrontendIPConfiguration) GetConditions() azcorev1.Conditions {
+	return fipc.Status.Conditions
+}
+
+func (fipc *FrontendIPConfiguration) SetConditions(conditions azcorev1.Conditions) {
+	fipc.Status.Conditions = conditions
+}
+
+func (inr *InboundNatRule) GetConditions() azcorev1.Conditions {
+	return inr.Status.Conditions
+}
+
+func (inr *InboundNatRule) SetConditions(conditions azcorev1.Conditions) {
+	inr.Status.Conditions = conditions
+}
+
+func (lb *LoadBalancer) GetConditions() azcorev1.Conditions {
+	return lb.Status.Conditions
+}
+
+func (lb *LoadBalancer) SetConditions(conditions azcorev1.Conditions) {
+	lb.Status.Conditions = conditions
+}
+
+func (lbr *LoadBalancingRule) GetConditions() azcorev1.Conditions {
+	return lbr.Status.Conditions
+}
+
+func (lbr *LoadBalancingRule) SetConditions(conditions azcorev1.Conditions) {
+	lbr.Status.Conditions = conditions
+}
+
+func (nic *NetworkInterface) GetConditions() azcorev1.Conditions {
+	return nic.Status.Conditions
+}
+
+func (nic *NetworkInterface) SetConditions(conditions azcorev1.Conditions) {
+	nic.Status.Conditions = conditions
+}
+
+func (ipc *NetworkInterfaceIPConfiguration) GetConditions() azcorev1.Conditions {
+	return ipc.Status.Conditions
+}
+
+func (ipc *NetworkInterfaceIPConfiguration) SetConditions(conditions azcorev1.Conditions) {
+	ipc.Status.Conditions = conditions
+}
+
+func (nsg *NetworkSecurityGroup) GetConditions() azcorev1.Conditions {
+	return nsg.Status.Conditions
+}
+
+func (nsg *NetworkSecurityGroup) SetConditions(conditions azcorev1.Conditions) {
+	nsg.Status.Conditions = conditions
+}
+
+func (or *OutboundRule) GetConditions() azcorev1.Conditions {
+	return or.Status.Conditions
+}
+
+func (or *OutboundRule) SetConditions(conditions azcorev1.Conditions) {
+	or.Status.Conditions = conditions
+}
+
+func (zone *PrivateDNSZone) GetConditions() azcorev1.Conditions {
+	return zone.Status.Conditions
+}
+
+func (zone *PrivateDNSZone) SetConditions(conditions azcorev1.Conditions) {
+	zone.Status.Conditions = conditions
+}
+
+func (pip *PublicIPAddress) GetConditions() azcorev1.Conditions {
+	return pip.Status.Conditions
+}
+
+func (pip *PublicIPAddress) SetConditions(conditions azcorev1.Conditions) {
+	pip.Status.Conditions = conditions
+}
+
+func (r *Route) GetConditions() azcorev1.Conditions {
+	return r.Status.Conditions
+}
+
+func (r *Route) SetConditions(conditions azcorev1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+func (rt *RouteTable) GetConditions() azcorev1.Conditions {
+	return rt.Status.Conditions
+}
+
+func (rt *RouteTable) SetConditions(conditions azcorev1.Conditions) {
+	rt.Status.Conditions = conditions
+}
+
+func (sr *SecurityRule) GetConditions() azcorev1.Conditions {
+	return sr.Status.Conditions
+}
+
+func (sr *SecurityRule) SetConditions(conditions azcorev1.Conditions) {
+	sr.Status.Conditions = conditions
+}
+
+func (s *Subnet) GetConditions() azcorev1.Conditions {
+	return s.Status.Conditions
+}
+
+func (s *Subnet) SetConditions(conditions azcorev1.Conditions) {
+	s.Status.Conditions = conditions
+}
+
+func (vnet *VirtualNetwork) GetConditions() azcorev1.Conditions {
+	return vnet.Status.Conditions
+}
+
+func (vnet *VirtualNetwork) SetConditions(conditions azcorev1.Conditions) {
+	vnet.Status.Conditions = conditions
+}
+
+func (link *VirtualNetworkLink) GetConditions() azcorev1.Conditions {
+	return link.Status.Conditions
+}
+
+func (link *VirtualNetworkLink) SetConditions(conditions azcorev1.Conditions) {
+	link.Status.Conditions = conditions
+}
+
+func (peering *VirtualNetworkPeering) GetConditions() azcorev1.Conditions {
+	return peering.Status.Conditions
+}
+
+func (peering *VirtualNetworkPeering) SetConditions(conditions azcorev1.Conditions) {
+	peering.Status.Conditions = conditions
+}
@@ -15,7 +15,7 @@ type (
 	// RouteTableSpecProperties are the resource specific properties
 	RouteTableSpecProperties struct {
 		DisableBGPRoutePropagation bool                          `json:"disableBgpRoutePropagation,omitempty"`
-		RouteRefs                  []azcorev1.KnownTypeReference `json:"routeRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"Route" owned:"true"`
+		RouteRefs                  []azcorev1.KnownTypeReference `json:"routeRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"Route" owned:"true" arm:"routes"`
 	}
 
 	// RouteTableSpec defines the desired state of RouteTable
@@ -25,11 +25,11 @@ type (
 
 		// ResourceGroupRef is the Azure Resource Group the VirtualNetwork resides within
 		// +kubebuilder:validation:Required
-		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup"`
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
 
 		// Location of the VNET in Azure
 		// +kubebuilder:validation:Required
-		Location string `json:"location"`
+		Location string `json:"location" immutable:"true"`
 
 		// Tags are user defined key value pairs
 		// +optional
@@ -37,6 +37,10 @@ type (
 
 		// Properties of the Virtual Network
 		Properties *RouteTableSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// RouteTableStatus defines the observed state of RouteTable
@@ -45,6 +49,12 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
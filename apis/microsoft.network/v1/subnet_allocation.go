@@ -0,0 +1,171 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	"github.com/Azure/k8s-infra/pkg/ipam"
+)
+
+var _ azcorev1.Allocator = &Subnet{}
+
+// Allocate ensures a subnet which requested a prefix length has a prefix of that length allocated from the address
+// space of its VirtualNetwork, allocating a new prefix if the requested length has changed. It returns false if the
+// allocation raced with another and should be tried again.
+//
+// Allocations are recorded in the status of the VirtualNetwork, which is updated with the resource version it was
+// read at. Of two subnets allocating from the same VirtualNetwork at once, only one update will succeed, so the same
+// prefix is never allocated twice, and a subnet which already has an allocation is always given the same prefix.
+func (r *Subnet) Allocate(ctx context.Context, c client.Client) (bool, string, error) {
+	if !r.RequiresAllocation() || r.hasAllocatedPrefixLength(r.Status.AllocatedAddressPrefix) {
+		return true, "", nil
+	}
+
+	vnet, err := getOwningVirtualNetwork(ctx, c, r)
+	if err != nil {
+		return false, "", err
+	}
+
+	if vnet == nil {
+		// the owner will be set once the VirtualNetwork has been reconciled
+		return false, "", nil
+	}
+
+	prefix, err := reserveSubnetPrefix(ctx, c, vnet, r)
+	if err != nil {
+		if apierrors.IsConflict(err) {
+			return false, "", nil
+		}
+		return false, "", err
+	}
+
+	r.Status.AllocatedAddressPrefix = prefix
+	return true, fmt.Sprintf("allocated address prefix %s from VirtualNetwork %q", prefix, vnet.Name), nil
+}
+
+// reserveSubnetPrefix returns the prefix allocated to the subnet by the VirtualNetwork, allocating the next free prefix
+// if it has none or its prefix is not of the requested length. Allocations of subnets which no longer exist are
+// released, as is the previous allocation of the subnet.
+func reserveSubnetPrefix(ctx context.Context, c client.Client, vnet *VirtualNetwork, subnet *Subnet) (string, error) {
+	var allocations []SubnetAllocation
+	var used []string
+	for _, allocation := range vnet.Status.SubnetAllocations {
+		if allocation.SubnetName == subnet.Name && allocation.SubnetNamespace == subnet.Namespace {
+			if subnet.hasAllocatedPrefixLength(allocation.AddressPrefix) {
+				return allocation.AddressPrefix, nil
+			}
+			continue
+		}
+
+		key := client.ObjectKey{Namespace: allocation.SubnetNamespace, Name: allocation.SubnetName}
+		if err := c.Get(ctx, key, new(Subnet)); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return "", fmt.Errorf("unable to get allocated Subnet %q with: %w", key, err)
+		}
+
+		allocations = append(allocations, allocation)
+		used = append(used, allocation.AddressPrefix)
+	}
+
+	var space []string
+	if props := vnet.Spec.Properties; props != nil {
+		if props.AddressSpace != nil {
+			space = props.AddressSpace.AddressPrefixes
+		}
+
+		for _, ref := range vnet.subnetRefs() {
+			if ref.Namespace == subnet.Namespace && ref.Name == subnet.Name {
+				continue
+			}
+
+			var sibling Subnet
+			key := client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+			if err := c.Get(ctx, key, &sibling); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return "", fmt.Errorf("unable to get Subnet %q with: %w", key, err)
+			}
+
+			used = append(used, sibling.AddressPrefixes()...)
+		}
+	}
+
+	allocated, err := allocatePrefix(space, used, subnet.Spec.AddressPrefixLength)
+	if err != nil {
+		return "", fmt.Errorf("unable to allocate a /%d from VirtualNetwork %q with: %w", subnet.Spec.AddressPrefixLength, vnet.Name, err)
+	}
+
+	vnet.Status.SubnetAllocations = append(allocations, SubnetAllocation{
+		SubnetName:      subnet.Name,
+		SubnetNamespace: subnet.Namespace,
+		AddressPrefix:   allocated.String(),
+	})
+
+	if err := c.Status().Update(ctx, vnet); err != nil {
+		if apierrors.IsConflict(err) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to record allocation in VirtualNetwork %q with: %w", vnet.Name, err)
+	}
+
+	return allocated.String(), nil
+}
+
+// getOwningVirtualNetwork returns the VirtualNetwork which owns the subnet, or nil if the subnet has no owner yet
+func getOwningVirtualNetwork(ctx context.Context, c client.Client, subnet *Subnet) (*VirtualNetwork, error) {
+	for _, ref := range subnet.OwnerReferences {
+		if ref.Kind != "VirtualNetwork" || ownerGroup(ref.APIVersion) != GroupVersion.Group {
+			continue
+		}
+
+		var vnet VirtualNetwork
+		key := client.ObjectKey{Namespace: subnet.Namespace, Name: ref.Name}
+		if err := c.Get(ctx, key, &vnet); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unable to get VirtualNetwork %q with: %w", key, err)
+		}
+		return &vnet, nil
+	}
+
+	return nil, nil
+}
+
+// hasAllocatedPrefixLength returns true if the allocated prefix is of the length the subnet requested
+func (r *Subnet) hasAllocatedPrefixLength(prefix string) bool {
+	_, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false
+	}
+
+	ones, _ := ipNet.Mask.Size()
+	return ones == r.Spec.AddressPrefixLength
+}
+
+func allocatePrefix(space, used []string, prefixLength int) (*net.IPNet, error) {
+	spaceNets, err := ipam.ParseCIDRs(space)
+	if err != nil {
+		return nil, err
+	}
+
+	usedNets, err := ipam.ParseCIDRs(used)
+	if err != nil {
+		return nil, err
+	}
+
+	return ipam.Allocate(spaceNets, usedNets, prefixLength)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+func TestSubnet_Allocate(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(gomega.Succeed())
+
+	vnet := &VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "vnet"},
+		Spec: VirtualNetworkSpec{
+			APIVersion: "2019-11-01",
+			Properties: &VirtualNetworkSpecProperties{
+				AddressSpace: &AddressSpaceSpec{AddressPrefixes: []string{"10.0.0.0/16"}},
+				SubnetRefs: []azcorev1.KnownTypeReference{
+					{Name: "subnet-a"},
+					{Name: "subnet-b"},
+				},
+			},
+		},
+	}
+	sibling := &Subnet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "subnet-a"},
+		Spec: SubnetSpec{
+			APIVersion: "2019-11-01",
+			Properties: SubnetProperties{AddressPrefix: "10.0.0.0/24"},
+		},
+	}
+	subnet := &Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "subnet-b",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: GroupVersion.String(), Kind: "VirtualNetwork", Name: "vnet"},
+			},
+		},
+		Spec: SubnetSpec{
+			APIVersion:          "2019-11-01",
+			AddressPrefixLength: 24,
+		},
+	}
+
+	c := fake.NewFakeClientWithScheme(scheme, vnet, sibling, subnet.DeepCopy())
+	var allocator azcorev1.Allocator = subnet
+	allocated, description, err := allocator.Allocate(context.Background(), c)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(allocated).To(gomega.BeTrue())
+	g.Expect(description).ToNot(gomega.BeEmpty())
+	g.Expect(subnet.Status.AllocatedAddressPrefix).To(gomega.Equal("10.0.1.0/24"))
+
+	var updated VirtualNetwork
+	g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "vnet"}, &updated)).To(gomega.Succeed())
+	g.Expect(updated.Status.SubnetAllocations).To(gomega.ConsistOf(SubnetAllocation{
+		SubnetName:      "subnet-b",
+		SubnetNamespace: "default",
+		AddressPrefix:   "10.0.1.0/24",
+	}))
+
+	// a subnet which already has a prefix is left alone
+	allocated, description, err = allocator.Allocate(context.Background(), c)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(allocated).To(gomega.BeTrue())
+	g.Expect(description).To(gomega.BeEmpty())
+
+	// changing the requested length replaces the allocation
+	subnet.Spec.AddressPrefixLength = 23
+	allocated, _, err = allocator.Allocate(context.Background(), c)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(allocated).To(gomega.BeTrue())
+	g.Expect(subnet.Status.AllocatedAddressPrefix).To(gomega.Equal("10.0.2.0/23"))
+
+	g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "vnet"}, &updated)).To(gomega.Succeed())
+	g.Expect(updated.Status.SubnetAllocations).To(gomega.ConsistOf(SubnetAllocation{
+		SubnetName:      "subnet-b",
+		SubnetNamespace: "default",
+		AddressPrefix:   "10.0.2.0/23",
+	}))
+}
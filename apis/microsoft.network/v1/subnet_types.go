@@ -7,6 +7,8 @@ package v1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
 
 type (
@@ -25,6 +27,16 @@ type (
 		APIVersion string `json:"apiVersion"`
 		// Properties of the subnet
 		Properties SubnetProperties `json:"properties,omitempty"`
+
+		// AddressPrefixLength requests that the next free prefix of the given length is allocated to the subnet from
+		// the address space of the VirtualNetwork, rather than specifying properties.addressPrefix
+		// +k8s:conversion-gen=false
+		// +optional
+		AddressPrefixLength int `json:"addressPrefixLength,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// SubnetStatus defines the observed state of Subnet
@@ -33,6 +45,18 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// IPConfigurations are the IP configurations using addresses from the subnet
+		// +k8s:conversion-gen=false
+		IPConfigurations []azcorev1.ARMIDReference `json:"ipConfigurations,omitempty" arm:"ipConfigurations"`
+		// AllocatedAddressPrefix is the prefix allocated to the subnet when it requested an addressPrefixLength
+		// +k8s:conversion-gen=false
+		AllocatedAddressPrefix string `json:"allocatedAddressPrefix,omitempty"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
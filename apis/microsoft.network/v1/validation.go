@@ -0,0 +1,371 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+const (
+	minSecurityRulePriority = 100
+	maxSecurityRulePriority = 4096
+
+	// maxIPv4SubnetPrefixLength is the smallest subnet Azure will create; 5 addresses of each subnet are reserved
+	maxIPv4SubnetPrefixLength = 29
+	minSubnetPrefixLength     = 8
+
+	routeNextHopVirtualAppliance = "VirtualAppliance"
+
+	ipAllocationMethodDynamic  = "Dynamic"
+	ipAllocationMethodStatic   = "Static"
+	publicIPAddressSKUStandard = "Standard"
+)
+
+var (
+	// supportedAPIVersions are the Microsoft.Network API versions the types in this group are able to apply
+	supportedAPIVersions = []string{defaultAPIVersion}
+
+	// supportedPrivateDNSAPIVersions are the API versions PrivateDNSZone and VirtualNetworkLink are able to apply
+	supportedPrivateDNSAPIVersions = []string{defaultPrivateDNSAPIVersion}
+
+	virtualNetworkIDRegex = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Network/virtualNetworks/[^/]+$`)
+
+	securityRuleAccesses   = []string{"Allow", "Deny"}
+	securityRuleDirections = []string{"Inbound", "Outbound"}
+	securityRuleProtocols  = []string{"*", "Ah", "Esp", "Icmp", "Tcp", "Udp"}
+	routeNextHopTypes      = []string{"Internet", "None", routeNextHopVirtualAppliance, "VirtualNetworkGateway", "VnetLocal"}
+)
+
+func (r *BackendAddressPool) validateSpec() field.ErrorList {
+	return azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))
+}
+
+func (r *FrontendIPConfiguration) validateSpec() field.ErrorList {
+	return azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))
+}
+
+func (r *InboundNatRule) validateSpec() field.ErrorList {
+	return azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))
+}
+
+func (r *LoadBalancer) validateSpec() field.ErrorList {
+	return azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))
+}
+
+func (r *LoadBalancingRule) validateSpec() field.ErrorList {
+	return azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))
+}
+
+func (r *NetworkInterface) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	if r.Spec.Properties == nil || len(r.Spec.Properties.IPConfigurationRefs) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("properties", "ipConfigurationRefs"), "at least one IP configuration is required"))
+	}
+
+	return allErrs
+}
+
+func (r *NetworkInterfaceIPConfiguration) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	if r.Spec.Properties != nil {
+		allErrs = append(allErrs, r.Spec.Properties.validate(specPath.Child("properties"))...)
+	}
+
+	return allErrs
+}
+
+func (r *NetworkSecurityGroup) validateSpec() field.ErrorList {
+	return azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))
+}
+
+func (r *OutboundRule) validateSpec() field.ErrorList {
+	return azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))
+}
+
+func (r *PrivateDNSZone) validateSpec() field.ErrorList {
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedPrivateDNSAPIVersions, field.NewPath("spec", "apiVersion"))
+
+	// the name of the object is the name of the zone, and Azure does not accept a single label zone
+	if !strings.Contains(strings.Trim(r.Name, "."), ".") {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), r.Name, "must have at least two labels, eg. contoso.internal"))
+	}
+
+	return allErrs
+}
+
+func (r *PublicIPAddress) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+
+	// a Standard public IP address is always statically allocated
+	if r.Spec.SKU == publicIPAddressSKUStandard && r.Spec.Properties != nil && r.Spec.Properties.PublicIPAllocationMethod == ipAllocationMethodDynamic {
+		allocationPath := specPath.Child("properties", "publicIPAllocationMethod")
+		allErrs = append(allErrs, field.Invalid(allocationPath, ipAllocationMethodDynamic, "must be Static when sku is Standard"))
+	}
+
+	return allErrs
+}
+
+func (r *RouteTable) validateSpec() field.ErrorList {
+	return azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))
+}
+
+func (r *Route) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	if r.Spec.Properties == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("properties"), ""))
+	} else {
+		allErrs = append(allErrs, r.Spec.Properties.validate(specPath.Child("properties"))...)
+	}
+
+	return allErrs
+}
+
+func (r *SecurityRule) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	if r.Spec.Properties == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("properties"), ""))
+	} else {
+		allErrs = append(allErrs, r.Spec.Properties.validate(specPath.Child("properties"))...)
+	}
+
+	return allErrs
+}
+
+func (r *Subnet) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	if r.Spec.AddressPrefixLength != 0 && (r.Spec.AddressPrefixLength < minSubnetPrefixLength || r.Spec.AddressPrefixLength > maxIPv4SubnetPrefixLength) {
+		msg := fmt.Sprintf("must be between %d and %d, inclusive", minSubnetPrefixLength, maxIPv4SubnetPrefixLength)
+		allErrs = append(allErrs, field.Invalid(specPath.Child("addressPrefixLength"), r.Spec.AddressPrefixLength, msg))
+	}
+
+	// the requested length would be ignored, as only a subnet without prefixes is allocated one
+	if r.Spec.AddressPrefixLength != 0 && (r.Spec.Properties.AddressPrefix != "" || len(r.Spec.Properties.AddressPrefixes) > 0) {
+		msg := "may not be set along with properties.addressPrefix or properties.addressPrefixes"
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("addressPrefixLength"), msg))
+	}
+
+	// a prefix will be allocated, so the subnet does not need to specify one
+	if !r.RequiresAllocation() {
+		allErrs = append(allErrs, r.Spec.Properties.validate(specPath.Child("properties"))...)
+	}
+	return allErrs
+}
+
+func (r *VirtualNetwork) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	propertiesPath := specPath.Child("properties")
+	if r.Spec.Properties == nil || r.Spec.Properties.AddressSpace == nil {
+		allErrs = append(allErrs, field.Required(propertiesPath.Child("addressSpace"), ""))
+	} else {
+		allErrs = append(allErrs, r.Spec.Properties.AddressSpace.validate(propertiesPath.Child("addressSpace"))...)
+	}
+
+	return allErrs
+}
+
+func (r *VirtualNetworkLink) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedPrivateDNSAPIVersions, specPath.Child("apiVersion"))
+	if r.Spec.Properties == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("properties"), ""))
+	} else {
+		propertiesPath := specPath.Child("properties")
+		p := r.Spec.Properties
+		allErrs = append(allErrs, validateVirtualNetworkRefOrID(p.VirtualNetworkRef, p.VirtualNetworkID,
+			propertiesPath.Child("virtualNetworkRef"), propertiesPath.Child("virtualNetworkId"))...)
+	}
+
+	return allErrs
+}
+
+func (r *VirtualNetworkPeering) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))
+	if r.Spec.Properties == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("properties"), ""))
+	} else {
+		allErrs = append(allErrs, r.Spec.Properties.validate(specPath.Child("properties"))...)
+	}
+
+	return allErrs
+}
+
+func validateEnum(value string, supported []string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+
+	if !contains(supported, value) {
+		return field.ErrorList{field.NotSupported(fldPath, value, supported)}
+	}
+
+	return nil
+}
+
+// validateCIDR ensures the prefix is in CIDR notation and is the first address of the range it describes, as Azure
+// will not accept a prefix with host bits set
+func validateCIDR(prefix string, fldPath *field.Path) (*net.IPNet, *field.Error) {
+	ip, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, field.Invalid(fldPath, prefix, "must be a valid CIDR, eg. 10.0.0.0/16")
+	}
+
+	if !ip.Equal(ipNet.IP) {
+		return nil, field.Invalid(fldPath, prefix, fmt.Sprintf("must be the first address of the range, eg. %s", ipNet))
+	}
+
+	return ipNet, nil
+}
+
+// validateCIDRs validates each prefix at the path of the same index, ensuring no prefix overlaps another and no IPv4
+// prefix is longer than maxIPv4PrefixLength
+func validateCIDRs(prefixes []string, paths []*field.Path, maxIPv4PrefixLength int) field.ErrorList {
+	var allErrs field.ErrorList
+	var ipNets []*net.IPNet
+	for i, prefix := range prefixes {
+		ipNet, fieldErr := validateCIDR(prefix, paths[i])
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+			continue
+		}
+
+		if ones, _ := ipNet.Mask.Size(); ipNet.IP.To4() != nil && ones > maxIPv4PrefixLength {
+			allErrs = append(allErrs, field.Invalid(paths[i], prefix, fmt.Sprintf("must be no smaller than a /%d", maxIPv4PrefixLength)))
+		}
+
+		for _, other := range ipNets {
+			if cidrsOverlap(ipNet, other) {
+				allErrs = append(allErrs, field.Invalid(paths[i], prefix, fmt.Sprintf("overlaps with %s", other)))
+			}
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+
+	return allErrs
+}
+
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func indexPaths(fldPath *field.Path, length int) []*field.Path {
+	paths := make([]*field.Path, length)
+	for i := range paths {
+		paths[i] = fldPath.Index(i)
+	}
+	return paths
+}
+
+func (as *AddressSpaceSpec) validate(fldPath *field.Path) field.ErrorList {
+	prefixesPath := fldPath.Child("addressPrefixes")
+	if len(as.AddressPrefixes) == 0 {
+		return field.ErrorList{field.Required(prefixesPath, "at least one address prefix is required")}
+	}
+
+	return validateCIDRs(as.AddressPrefixes, indexPaths(prefixesPath, len(as.AddressPrefixes)), net.IPv4len*8)
+}
+
+func (sp *SubnetProperties) validate(fldPath *field.Path) field.ErrorList {
+	if sp.AddressPrefix == "" && len(sp.AddressPrefixes) == 0 {
+		return field.ErrorList{field.Required(fldPath.Child("addressPrefix"), "either addressPrefix or addressPrefixes is required")}
+	}
+
+	// addressPrefix and addressPrefixes are validated together, as neither may overlap the other
+	prefixes, paths := sp.prefixes(fldPath)
+	return validateCIDRs(prefixes, paths, maxIPv4SubnetPrefixLength)
+}
+
+func (srp *SecurityRuleSpecProperties) validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if srp.Priority < minSecurityRulePriority || srp.Priority > maxSecurityRulePriority {
+		msg := fmt.Sprintf("must be between %d and %d, inclusive", minSecurityRulePriority, maxSecurityRulePriority)
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("priority"), srp.Priority, msg))
+	}
+
+	allErrs = append(allErrs, validateEnum(srp.Access, securityRuleAccesses, fldPath.Child("access"))...)
+	allErrs = append(allErrs, validateEnum(srp.Direction, securityRuleDirections, fldPath.Child("direction"))...)
+	allErrs = append(allErrs, validateEnum(srp.Protocol, securityRuleProtocols, fldPath.Child("protocol"))...)
+	return allErrs
+}
+
+func (rp *RouteSpecProperties) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := validateEnum(rp.NextHopType, routeNextHopTypes, fldPath.Child("nextHopType"))
+
+	nextHopIPPath := fldPath.Child("nextHopIpAddress")
+	switch {
+	case rp.NextHopType == routeNextHopVirtualAppliance && rp.NextHopIPAddress == "":
+		allErrs = append(allErrs, field.Required(nextHopIPPath, "required when nextHopType is VirtualAppliance"))
+	case rp.NextHopType == routeNextHopVirtualAppliance && net.ParseIP(rp.NextHopIPAddress) == nil:
+		allErrs = append(allErrs, field.Invalid(nextHopIPPath, rp.NextHopIPAddress, "must be a valid IP address"))
+	case rp.NextHopType != routeNextHopVirtualAppliance && rp.NextHopIPAddress != "":
+		allErrs = append(allErrs, field.Forbidden(nextHopIPPath, "only allowed when nextHopType is VirtualAppliance"))
+	}
+
+	return allErrs
+}
+
+func (ipc *NetworkInterfaceIPConfigurationSpecProperties) validate(fldPath *field.Path) field.ErrorList {
+	addressPath := fldPath.Child("privateIPAddress")
+	switch {
+	case ipc.PrivateIPAllocationMethod == ipAllocationMethodStatic && ipc.PrivateIPAddress == "":
+		return field.ErrorList{field.Required(addressPath, "required when privateIPAllocationMethod is Static")}
+	case ipc.PrivateIPAddress != "" && net.ParseIP(ipc.PrivateIPAddress) == nil:
+		return field.ErrorList{field.Invalid(addressPath, ipc.PrivateIPAddress, "must be a valid IP address")}
+	}
+
+	return nil
+}
+
+func (vnpp *VirtualNetworkPeeringSpecProperties) validate(fldPath *field.Path) field.ErrorList {
+	allErrs := validateVirtualNetworkRefOrID(vnpp.RemoteVirtualNetworkRef, vnpp.RemoteVirtualNetworkID,
+		fldPath.Child("remoteVirtualNetworkRef"), fldPath.Child("remoteVirtualNetworkId"))
+
+	// a virtual network either offers its gateway to its peers or uses the gateway of a peer, not both
+	if vnpp.AllowGatewayTransit && vnpp.UseRemoteGateways {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("useRemoteGateways"), "may not be set when allowGatewayTransit is set"))
+	}
+
+	return allErrs
+}
+
+// validateVirtualNetworkRefOrID ensures exactly one of a reference to a VirtualNetwork or the ARM ID of a virtual
+// network is set
+func validateVirtualNetworkRefOrID(ref *azcorev1.KnownTypeReference, id string, refPath, idPath *field.Path) field.ErrorList {
+	hasRef := ref != nil && ref.Name != ""
+	switch {
+	case hasRef && id != "":
+		return field.ErrorList{field.Forbidden(idPath, fmt.Sprintf("may not be set when %s is set", refPath.String()))}
+	case !hasRef && id == "":
+		return field.ErrorList{field.Required(refPath, fmt.Sprintf("either %s or %s is required", refPath.String(), idPath.String()))}
+	case id != "" && !virtualNetworkIDRegex.MatchString(id):
+		return field.ErrorList{field.Invalid(idPath, id, "must be the ARM ID of a virtual network, eg. /subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}")}
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,373 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+func TestVirtualNetwork_ValidateCreate(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Prefixes     []string
+		APIVersion   string
+		ExpectFields []string
+	}{
+		{
+			Name:       "Valid",
+			Prefixes:   []string{"10.0.0.0/16", "10.1.0.0/16"},
+			APIVersion: "2019-11-01",
+		},
+		{
+			Name:         "InvalidCIDR",
+			Prefixes:     []string{"10.0.0.0/33", "10.1.0.1/16"},
+			APIVersion:   "2019-11-01",
+			ExpectFields: []string{"spec.properties.addressSpace.addressPrefixes[0]", "spec.properties.addressSpace.addressPrefixes[1]"},
+		},
+		{
+			Name:         "OverlappingPrefixes",
+			Prefixes:     []string{"10.0.0.0/16", "10.0.1.0/24"},
+			APIVersion:   "2019-11-01",
+			ExpectFields: []string{"spec.properties.addressSpace.addressPrefixes[1]"},
+		},
+		{
+			Name:         "UnsupportedAPIVersion",
+			Prefixes:     []string{"10.0.0.0/16"},
+			APIVersion:   "2015-01-01",
+			ExpectFields: []string{"spec.apiVersion"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			vnet := &VirtualNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "vnet"},
+				Spec: VirtualNetworkSpec{
+					APIVersion: c.APIVersion,
+					Properties: &VirtualNetworkSpecProperties{
+						AddressSpace: &AddressSpaceSpec{
+							AddressPrefixes: c.Prefixes,
+						},
+					},
+				},
+			}
+
+			g := gomega.NewGomegaWithT(t)
+			expectInvalidFields(g, vnet.ValidateCreate(), c.ExpectFields)
+		})
+	}
+}
+
+func TestSubnet_ValidateCreate(t *testing.T) {
+	subnet := &Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: "subnet"},
+		Spec: SubnetSpec{
+			APIVersion: "2019-11-01",
+			Properties: SubnetProperties{
+				AddressPrefix:   "10.0.0.0/24",
+				AddressPrefixes: []string{"10.0.1.0/24", "10.0.0.128/25", "10.0.2.0/30"},
+			},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	expectInvalidFields(g, subnet.ValidateCreate(), []string{"spec.properties.addressPrefixes[1]", "spec.properties.addressPrefixes[2]"})
+
+	subnet.Spec.Properties.AddressPrefixes = nil
+	subnet.Spec.AddressPrefixLength = 24
+	expectInvalidFields(g, subnet.ValidateCreate(), []string{"spec.addressPrefixLength"})
+}
+
+func TestSecurityRule_ValidateCreate(t *testing.T) {
+	rule := &SecurityRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "rule"},
+		Spec: SecurityRuleSpec{
+			APIVersion: "2019-11-01",
+			Properties: &SecurityRuleSpecProperties{
+				Access:    "Allow",
+				Direction: "Sideways",
+				Priority:  99,
+				Protocol:  "Tcp",
+			},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	expectInvalidFields(g, rule.ValidateCreate(), []string{"spec.properties.priority", "spec.properties.direction"})
+
+	rule.Spec.Properties.Direction = "Inbound"
+	rule.Spec.Properties.Priority = 4096
+	g.Expect(rule.ValidateCreate()).To(gomega.Succeed())
+}
+
+func TestRoute_ValidateUpdate(t *testing.T) {
+	route := &Route{
+		ObjectMeta: metav1.ObjectMeta{Name: "route"},
+		Spec: RouteSpec{
+			APIVersion: "2019-11-01",
+			Properties: &RouteSpecProperties{
+				AddressPrefix: "0.0.0.0/0",
+				NextHopType:   "VirtualAppliance",
+			},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	expectInvalidFields(g, route.ValidateUpdate(route.DeepCopy()), []string{"spec.properties.nextHopIpAddress"})
+
+	route.Spec.Properties.NextHopIPAddress = "10.0.0.4"
+	g.Expect(route.ValidateUpdate(route.DeepCopy())).To(gomega.Succeed())
+
+	route.Spec.Properties.NextHopType = "Internet"
+	expectInvalidFields(g, route.ValidateUpdate(route.DeepCopy()), []string{"spec.properties.nextHopIpAddress"})
+}
+
+func TestPublicIPAddress_ValidateCreate(t *testing.T) {
+	pip := &PublicIPAddress{
+		ObjectMeta: metav1.ObjectMeta{Name: "pip"},
+		Spec: PublicIPAddressSpec{
+			APIVersion: "2019-11-01",
+			SKU:        "Standard",
+			Properties: &PublicIPAddressSpecProperties{
+				PublicIPAllocationMethod: "Dynamic",
+			},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	expectInvalidFields(g, pip.ValidateCreate(), []string{"spec.properties.publicIPAllocationMethod"})
+
+	pip.Spec.Properties.PublicIPAllocationMethod = "Static"
+	g.Expect(pip.ValidateCreate()).To(gomega.Succeed())
+}
+
+func TestLoadBalancer_ValidateUpdate_ImmutableFields(t *testing.T) {
+	old := &LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{Name: "lb"},
+		Spec: LoadBalancerSpec{
+			APIVersion:       "2019-11-01",
+			Location:         "westus2",
+			SKU:              "Basic",
+			ResourceGroupRef: &azcorev1.KnownTypeReference{Name: "rg"},
+		},
+		Status: LoadBalancerStatus{ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/lb"},
+	}
+
+	lb := old.DeepCopy()
+	lb.Spec.Location = "eastus"
+	lb.Spec.SKU = "Standard"
+	lb.Spec.ResourceGroupRef.Name = "other-rg"
+	lb.Spec.Tags = map[string]string{"env": "test"}
+
+	g := gomega.NewGomegaWithT(t)
+	expectInvalidFields(g, lb.ValidateUpdate(old), []string{"spec.location", "spec.sku", "spec.resourceGroupRef"})
+
+	lb.Annotations = map[string]string{azcorev1.RecreateAnnotation: "true"}
+	g.Expect(lb.ValidateUpdate(old)).To(gomega.Succeed())
+
+	// defaulting the namespace of the resource group reference is not a change
+	old.Namespace = "default"
+	lb = old.DeepCopy()
+	lb.Default()
+	g.Expect(lb.ValidateUpdate(old)).To(gomega.Succeed())
+}
+
+func TestLoadBalancer_ValidateUpdate_ImmutableFieldsBeforeCreation(t *testing.T) {
+	old := &LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{Name: "lb"},
+		Spec: LoadBalancerSpec{
+			APIVersion:       "2019-11-01",
+			Location:         "westus2",
+			SKU:              "Basic",
+			ResourceGroupRef: &azcorev1.KnownTypeReference{Name: "rg"},
+		},
+		Status: LoadBalancerStatus{ProvisioningState: "Failed"},
+	}
+
+	// the resource was never created in Azure, so a typo can be fixed without recreating it
+	lb := old.DeepCopy()
+	lb.Spec.Location = "eastus"
+	lb.Spec.SKU = "Standard"
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(lb.ValidateUpdate(old)).To(gomega.Succeed())
+}
+
+func TestSubnet_ValidateUpdate_ParentUnchanged(t *testing.T) {
+	old := &Subnet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "Subnet",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "subnet",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: GroupVersion.String(), Kind: "VirtualNetwork", Name: "vnet", UID: "1"},
+			},
+		},
+		Spec: SubnetSpec{
+			APIVersion: "2019-11-01",
+			Properties: SubnetProperties{
+				AddressPrefix: "10.0.0.0/24",
+			},
+		},
+		Status: SubnetStatus{ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet"},
+	}
+
+	subnet := old.DeepCopy()
+	subnet.OwnerReferences = append(subnet.OwnerReferences, metav1.OwnerReference{
+		APIVersion: GroupVersion.String(), Kind: "VirtualNetwork", Name: "other-vnet", UID: "2",
+	})
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(old.DeepCopy().ValidateUpdate(old)).To(gomega.Succeed())
+	expectInvalidFields(g, subnet.ValidateUpdate(old), []string{"metadata.ownerReferences[1]"})
+}
+
+func TestVirtualNetwork_ValidateDelete(t *testing.T) {
+	vnet := &VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "vnet"},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(vnet.ValidateDelete()).To(gomega.Succeed())
+
+	vnet.Annotations = map[string]string{azcorev1.DeletionProtectionAnnotation: "true"}
+	g.Expect(apierrors.IsForbidden(vnet.ValidateDelete())).To(gomega.BeTrue())
+
+	azcorev1.ConfigureDeletionProtection([]schema.GroupKind{GroupVersion.WithKind("VirtualNetwork").GroupKind()})
+	defer azcorev1.ConfigureDeletionProtection(nil)
+
+	vnet.Annotations = nil
+	g.Expect(apierrors.IsForbidden(vnet.ValidateDelete())).To(gomega.BeTrue())
+
+	// objects may opt out of the policy
+	vnet.Annotations = map[string]string{azcorev1.DeletionProtectionAnnotation: "false"}
+	g.Expect(vnet.ValidateDelete()).To(gomega.Succeed())
+
+	// other kinds are not protected by the policy
+	subnet := &Subnet{ObjectMeta: metav1.ObjectMeta{Name: "subnet"}}
+	g.Expect(subnet.ValidateDelete()).To(gomega.Succeed())
+}
+
+func TestVirtualNetworkPeering_ValidateCreate(t *testing.T) {
+	remoteID := "/subscriptions/sub/resourceGroups/hub/providers/Microsoft.Network/virtualNetworks/hub"
+	cases := []struct {
+		Name         string
+		Properties   VirtualNetworkPeeringSpecProperties
+		ExpectFields []string
+	}{
+		{
+			Name:       "ValidRef",
+			Properties: VirtualNetworkPeeringSpecProperties{RemoteVirtualNetworkRef: &azcorev1.KnownTypeReference{Name: "hub"}},
+		},
+		{
+			Name:       "ValidID",
+			Properties: VirtualNetworkPeeringSpecProperties{RemoteVirtualNetworkID: remoteID},
+		},
+		{
+			Name:         "MissingRemote",
+			ExpectFields: []string{"spec.properties.remoteVirtualNetworkRef"},
+		},
+		{
+			Name: "RefAndID",
+			Properties: VirtualNetworkPeeringSpecProperties{
+				RemoteVirtualNetworkRef: &azcorev1.KnownTypeReference{Name: "hub"},
+				RemoteVirtualNetworkID:  remoteID,
+			},
+			ExpectFields: []string{"spec.properties.remoteVirtualNetworkId"},
+		},
+		{
+			Name:         "InvalidID",
+			Properties:   VirtualNetworkPeeringSpecProperties{RemoteVirtualNetworkID: "/subscriptions/sub/resourceGroups/hub"},
+			ExpectFields: []string{"spec.properties.remoteVirtualNetworkId"},
+		},
+		{
+			Name: "GatewayTransitWithRemoteGateways",
+			Properties: VirtualNetworkPeeringSpecProperties{
+				AllowGatewayTransit:    true,
+				UseRemoteGateways:      true,
+				RemoteVirtualNetworkID: remoteID,
+			},
+			ExpectFields: []string{"spec.properties.useRemoteGateways"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			peering := &VirtualNetworkPeering{
+				ObjectMeta: metav1.ObjectMeta{Name: "spoke-to-hub"},
+				Spec: VirtualNetworkPeeringSpec{
+					APIVersion: "2019-11-01",
+					Properties: &c.Properties,
+				},
+			}
+
+			g := gomega.NewGomegaWithT(t)
+			expectInvalidFields(g, peering.ValidateCreate(), c.ExpectFields)
+		})
+	}
+}
+
+func TestPrivateDNSZone_ValidateCreate(t *testing.T) {
+	zone := &PrivateDNSZone{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal"},
+		Spec: PrivateDNSZoneSpec{
+			APIVersion:       "2019-11-01",
+			ResourceGroupRef: &azcorev1.KnownTypeReference{Name: "rg"},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	expectInvalidFields(g, zone.ValidateCreate(), []string{"spec.apiVersion", "metadata.name"})
+
+	// private DNS zones are versioned separately from the rest of the group
+	zone.Name = "contoso.internal"
+	zone.Spec.APIVersion = ""
+	zone.Default()
+	g.Expect(zone.Spec.APIVersion).To(gomega.Equal("2018-09-01"))
+	g.Expect(zone.ValidateCreate()).To(gomega.Succeed())
+}
+
+func TestVirtualNetworkLink_ValidateCreate(t *testing.T) {
+	link := &VirtualNetworkLink{
+		ObjectMeta: metav1.ObjectMeta{Name: "link"},
+		Spec: VirtualNetworkLinkSpec{
+			APIVersion: "2018-09-01",
+			Properties: &VirtualNetworkLinkSpecProperties{},
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	expectInvalidFields(g, link.ValidateCreate(), []string{"spec.properties.virtualNetworkRef"})
+
+	link.Spec.Properties.VirtualNetworkRef = &azcorev1.KnownTypeReference{Name: "vnet"}
+	g.Expect(link.ValidateCreate()).To(gomega.Succeed())
+}
+
+func expectInvalidFields(g *gomega.GomegaWithT, err error, fields []string) {
+	if len(fields) == 0 {
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		return
+	}
+
+	g.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue(), "expected an Invalid error, but got %v", err)
+	status := err.(apierrors.APIStatus).Status()
+	var actual []string
+	for _, cause := range status.Details.Causes {
+		actual = append(actual, cause.Field)
+	}
+	g.Expect(actual).To(gomega.ConsistOf(fields))
+}
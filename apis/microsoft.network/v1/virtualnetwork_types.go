@@ -33,6 +33,18 @@ type (
 		VirtualNetworkCommunity string `json:"virtualNetworkCommunity,omitempty"`
 	}
 
+	// SubnetAllocation is an address prefix allocated to a subnet from the address space of the virtual network
+	SubnetAllocation struct {
+		// SubnetName is the name of the Subnet the prefix is allocated to
+		SubnetName string `json:"subnetName"`
+
+		// SubnetNamespace is the namespace of the Subnet the prefix is allocated to
+		SubnetNamespace string `json:"subnetNamespace"`
+
+		// AddressPrefix is the prefix allocated to the subnet, eg. 10.0.1.0/24
+		AddressPrefix string `json:"addressPrefix"`
+	}
+
 	// VirtualNetworkSpecProperties are the property bodies to be applied
 	VirtualNetworkSpecProperties struct {
 		// AddressSpace contains an array of IP address ranges that can be used by subnets
@@ -48,7 +60,11 @@ type (
 
 		// Subnets is a list of subnets in the VNET
 		// +optional
-		SubnetRefs []azcorev1.KnownTypeReference `json:"subnetRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"Subnet" owned:"true"`
+		SubnetRefs []azcorev1.KnownTypeReference `json:"subnetRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"Subnet" owned:"true" arm:"subnets"`
+
+		// VirtualNetworkPeeringRefs are the peerings of the VNET with other virtual networks
+		// +optional
+		VirtualNetworkPeeringRefs []azcorev1.KnownTypeReference `json:"virtualNetworkPeeringRefs,omitempty" group:"microsoft.network.infra.azure.com" kind:"VirtualNetworkPeering" owned:"true" arm:"virtualNetworkPeerings"`
 
 		// EnableVMProtection indicates if VM protection is enabled for all the subnets in the virtual network
 		// +optional
@@ -61,13 +77,17 @@ type (
 		APIVersion string `json:"apiVersion"`
 
 		// ResourceGroupRef is the Azure Resource Group the VirtualNetwork resides within
-		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup"`
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
 
-		Location string            `json:"location,omitempty"`
+		Location string            `json:"location,omitempty" immutable:"true"`
 		Tags     map[string]string `json:"tags,omitempty"`
 
 		// Properties of the Virtual Network
 		Properties *VirtualNetworkSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// VirtualNetworkStatus defines the observed state of VirtualNetwork
@@ -76,6 +96,19 @@ type (
 		// +k8s:conversion-gen=false
 		DeploymentID      string `json:"deploymentId,omitempty"`
 		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// ResourceGUID is the unique identifier Azure assigned to the virtual network
+		// +k8s:conversion-gen=false
+		ResourceGUID string `json:"resourceGuid,omitempty" arm:"resourceGuid"`
+		// SubnetAllocations are the address prefixes allocated to subnets which requested a prefix length rather than
+		// an address prefix
+		// +k8s:conversion-gen=false
+		SubnetAllocations []SubnetAllocation `json:"subnetAllocations,omitempty"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 	}
 
 	// +kubebuilder:object:root=true
@@ -46,3 +46,22 @@ func TestVirtualNetwork_Marshalling(t *testing.T) {
 	g.Expect(js).To(gomega.ContainSubstring("subnetRefs"))
 	g.Expect(js).To(gomega.ContainSubstring("addressSpace"))
 }
+
+func TestVirtualNetwork_SpecSignatureIgnoresOutputs(t *testing.T) {
+	vnet := &VirtualNetwork{
+		Spec: VirtualNetworkSpec{
+			APIVersion: "api",
+			Location:   "westus2",
+		},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	sig, err := v1.SpecSignature(vnet)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	vnet.Spec.Outputs = &v1.OutputsSpec{ConfigMapName: "vnet-outputs"}
+	g.Expect(v1.SpecSignature(vnet)).To(gomega.Equal(sig))
+
+	vnet.Spec.Location = "eastus"
+	g.Expect(v1.SpecSignature(vnet)).ToNot(gomega.Equal(sig))
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// VirtualNetworkLinkSpecProperties are the resource specific properties
+	VirtualNetworkLinkSpecProperties struct {
+		// RegistrationEnabled automatically registers records in the zone for the VMs of the virtual network
+		// +optional
+		RegistrationEnabled bool `json:"registrationEnabled,omitempty" arm:",required"`
+
+		// VirtualNetworkRef is the VirtualNetwork linked to the zone. Either virtualNetworkRef or virtualNetworkId is
+		// required.
+		// +optional
+		VirtualNetworkRef *azcorev1.KnownTypeReference `json:"virtualNetworkRef,omitempty" group:"microsoft.network.infra.azure.com" kind:"VirtualNetwork" immutable:"true" arm:"virtualNetwork,required,id=VirtualNetworkID"`
+
+		// VirtualNetworkID is the ARM ID of the virtual network linked to the zone, for a virtual network which is not
+		// managed within the cluster
+		// +optional
+		VirtualNetworkID string `json:"virtualNetworkId,omitempty" immutable:"true" arm:"-"`
+	}
+
+	// VirtualNetworkLinkSpec defines the desired state of VirtualNetworkLink
+	VirtualNetworkLinkSpec struct {
+		// +k8s:conversion-gen=false
+		APIVersion string `json:"apiVersion"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the VirtualNetworkLink
+		// +kubebuilder:validation:Required
+		Properties *VirtualNetworkLinkSpecProperties `json:"properties"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// VirtualNetworkLinkStatus defines the observed state of VirtualNetworkLink
+	VirtualNetworkLinkStatus struct {
+		ID string `json:"id,omitempty"`
+		// +k8s:conversion-gen=false
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+		// ETag is the entity tag of the resource in Azure
+		// +k8s:conversion-gen=false
+		ETag string `json:"etag,omitempty"`
+		// VirtualNetworkLinkState is InProgress until the zone resolves within the virtual network, at which point it
+		// is Completed
+		// +k8s:conversion-gen=false
+		VirtualNetworkLinkState string `json:"virtualNetworkLinkState,omitempty" arm:"virtualNetworkLinkState"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+	// +kubebuilder:subresource:status
+	// +kubebuilder:storageversion
+
+	// VirtualNetworkLink is the Schema for the virtualnetworklinks API
+	VirtualNetworkLink struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   VirtualNetworkLinkSpec   `json:"spec,omitempty"`
+		Status VirtualNetworkLinkStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VirtualNetworkLinkList contains a list of VirtualNetworkLink
+	VirtualNetworkLinkList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []VirtualNetworkLink `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&VirtualNetworkLink{}, &VirtualNetworkLinkList{})
+}
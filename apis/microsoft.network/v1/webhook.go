@@ -10,6 +10,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
 
 // log is for logging in this package.
@@ -23,11 +25,13 @@ var _ webhook.Defaulter = &BackendAddressPool{}
 func (r *BackendAddressPool) Default() {
 	backendaddresspoollog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-backendaddresspool,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=backendaddresspools,versions=v1,name=validation.backendaddresspool.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-backendaddresspool,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=backendaddresspools,versions=v1,name=validation.backendaddresspool.infra.azure.com
 
 var _ webhook.Validator = &BackendAddressPool{}
 
@@ -35,24 +39,21 @@ var _ webhook.Validator = &BackendAddressPool{}
 func (r *BackendAddressPool) ValidateCreate() error {
 	backendaddresspoollog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("BackendAddressPool").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *BackendAddressPool) ValidateUpdate(old runtime.Object) error {
 	backendaddresspoollog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("BackendAddressPool").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *BackendAddressPool) ValidateDelete() error {
 	backendaddresspoollog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("BackendAddressPool").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -66,11 +67,13 @@ var _ webhook.Defaulter = &FrontendIPConfiguration{}
 func (r *FrontendIPConfiguration) Default() {
 	frontendipconfigurationlog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-frontendipconfiguration,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=frontendipconfigurations,versions=v1,name=validation.frontendipconfiguration.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-frontendipconfiguration,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=frontendipconfigurations,versions=v1,name=validation.frontendipconfiguration.infra.azure.com
 
 var _ webhook.Validator = &FrontendIPConfiguration{}
 
@@ -78,24 +81,21 @@ var _ webhook.Validator = &FrontendIPConfiguration{}
 func (r *FrontendIPConfiguration) ValidateCreate() error {
 	frontendipconfigurationlog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("FrontendIPConfiguration").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *FrontendIPConfiguration) ValidateUpdate(old runtime.Object) error {
 	frontendipconfigurationlog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("FrontendIPConfiguration").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *FrontendIPConfiguration) ValidateDelete() error {
 	frontendipconfigurationlog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("FrontendIPConfiguration").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -109,11 +109,13 @@ var _ webhook.Defaulter = &InboundNatRule{}
 func (r *InboundNatRule) Default() {
 	inboundnatrulelog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-inboundnatrule,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=inboundnatrules,versions=v1,name=validation.inboundnatrule.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-inboundnatrule,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=inboundnatrules,versions=v1,name=validation.inboundnatrule.infra.azure.com
 
 var _ webhook.Validator = &InboundNatRule{}
 
@@ -121,24 +123,21 @@ var _ webhook.Validator = &InboundNatRule{}
 func (r *InboundNatRule) ValidateCreate() error {
 	inboundnatrulelog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("InboundNatRule").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *InboundNatRule) ValidateUpdate(old runtime.Object) error {
 	inboundnatrulelog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("InboundNatRule").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *InboundNatRule) ValidateDelete() error {
 	inboundnatrulelog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("InboundNatRule").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -152,11 +151,15 @@ var _ webhook.Defaulter = &LoadBalancer{}
 func (r *LoadBalancer) Default() {
 	loadbalancerlog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-loadbalancer,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=loadbalancers,versions=v1,name=validation.loadbalancer.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-loadbalancer,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=loadbalancers,versions=v1,name=validation.loadbalancer.infra.azure.com
 
 var _ webhook.Validator = &LoadBalancer{}
 
@@ -164,24 +167,21 @@ var _ webhook.Validator = &LoadBalancer{}
 func (r *LoadBalancer) ValidateCreate() error {
 	loadbalancerlog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("LoadBalancer").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *LoadBalancer) ValidateUpdate(old runtime.Object) error {
 	loadbalancerlog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("LoadBalancer").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *LoadBalancer) ValidateDelete() error {
 	loadbalancerlog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("LoadBalancer").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -195,11 +195,13 @@ var _ webhook.Defaulter = &LoadBalancingRule{}
 func (r *LoadBalancingRule) Default() {
 	loadbalancingrulelog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-loadbalancingrule,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=loadbalancingrules,versions=v1,name=validation.loadbalancingrule.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-loadbalancingrule,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=loadbalancingrules,versions=v1,name=validation.loadbalancingrule.infra.azure.com
 
 var _ webhook.Validator = &LoadBalancingRule{}
 
@@ -207,24 +209,65 @@ var _ webhook.Validator = &LoadBalancingRule{}
 func (r *LoadBalancingRule) ValidateCreate() error {
 	loadbalancingrulelog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("LoadBalancingRule").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *LoadBalancingRule) ValidateUpdate(old runtime.Object) error {
 	loadbalancingrulelog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("LoadBalancingRule").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *LoadBalancingRule) ValidateDelete() error {
 	loadbalancingrulelog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("LoadBalancingRule").GroupKind(), r)
+}
+
+// log is for logging in this package.
+var networkinterfacelog = logf.Log.WithName("networkinterface-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-network-infra-azure-com-v1-networkinterface,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=networkinterfaces,verbs=create;update,versions=v1,name=default.networkinterface.infra.azure.com
+
+var _ webhook.Defaulter = &NetworkInterface{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *NetworkInterface) Default() {
+	networkinterfacelog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-networkinterface,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=networkinterfaces,versions=v1,name=validation.networkinterface.infra.azure.com
+
+var _ webhook.Validator = &NetworkInterface{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *NetworkInterface) ValidateCreate() error {
+	networkinterfacelog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("NetworkInterface").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *NetworkInterface) ValidateUpdate(old runtime.Object) error {
+	networkinterfacelog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("NetworkInterface").GroupKind(), old, r, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *NetworkInterface) ValidateDelete() error {
+	networkinterfacelog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("NetworkInterface").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -246,11 +289,13 @@ var _ webhook.Defaulter = &NetworkInterfaceIPConfiguration{}
 func (r *NetworkInterfaceIPConfiguration) Default() {
 	networkinterfaceipconfigurationlog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-networkinterfaceipconfiguration,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=networkinterfaceipconfigurations,versions=v1,name=validation.networkinterfaceipconfiguration.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-networkinterfaceipconfiguration,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=networkinterfaceipconfigurations,versions=v1,name=validation.networkinterfaceipconfiguration.infra.azure.com
 
 var _ webhook.Validator = &NetworkInterfaceIPConfiguration{}
 
@@ -258,24 +303,21 @@ var _ webhook.Validator = &NetworkInterfaceIPConfiguration{}
 func (r *NetworkInterfaceIPConfiguration) ValidateCreate() error {
 	networkinterfaceipconfigurationlog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("NetworkInterfaceIPConfiguration").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *NetworkInterfaceIPConfiguration) ValidateUpdate(old runtime.Object) error {
 	networkinterfaceipconfigurationlog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("NetworkInterfaceIPConfiguration").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *NetworkInterfaceIPConfiguration) ValidateDelete() error {
 	networkinterfaceipconfigurationlog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("NetworkInterfaceIPConfiguration").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -289,11 +331,15 @@ var _ webhook.Defaulter = &NetworkSecurityGroup{}
 func (r *NetworkSecurityGroup) Default() {
 	networksecuritygrouplog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-networksecuritygroup,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=networksecuritygroups,versions=v1,name=validation.networksecuritygroup.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-networksecuritygroup,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=networksecuritygroups,versions=v1,name=validation.networksecuritygroup.infra.azure.com
 
 var _ webhook.Validator = &NetworkSecurityGroup{}
 
@@ -301,24 +347,21 @@ var _ webhook.Validator = &NetworkSecurityGroup{}
 func (r *NetworkSecurityGroup) ValidateCreate() error {
 	networksecuritygrouplog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("NetworkSecurityGroup").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *NetworkSecurityGroup) ValidateUpdate(old runtime.Object) error {
 	networksecuritygrouplog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("NetworkSecurityGroup").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *NetworkSecurityGroup) ValidateDelete() error {
 	networksecuritygrouplog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("NetworkSecurityGroup").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -332,11 +375,13 @@ var _ webhook.Defaulter = &OutboundRule{}
 func (r *OutboundRule) Default() {
 	outboundrulelog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-outboundrule,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=outboundrules,versions=v1,name=validation.outboundrule.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-outboundrule,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=outboundrules,versions=v1,name=validation.outboundrule.infra.azure.com
 
 var _ webhook.Validator = &OutboundRule{}
 
@@ -344,24 +389,108 @@ var _ webhook.Validator = &OutboundRule{}
 func (r *OutboundRule) ValidateCreate() error {
 	outboundrulelog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("OutboundRule").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *OutboundRule) ValidateUpdate(old runtime.Object) error {
 	outboundrulelog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("OutboundRule").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *OutboundRule) ValidateDelete() error {
 	outboundrulelog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("OutboundRule").GroupKind(), r)
+}
+
+// log is for logging in this package.
+var privatednszonelog = logf.Log.WithName("privatednszone-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-network-infra-azure-com-v1-privatednszone,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=privatednszones,verbs=create;update,versions=v1,name=default.privatednszone.infra.azure.com
+
+var _ webhook.Defaulter = &PrivateDNSZone{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *PrivateDNSZone) Default() {
+	privatednszonelog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultPrivateDNSAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-privatednszone,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=privatednszones,versions=v1,name=validation.privatednszone.infra.azure.com
+
+var _ webhook.Validator = &PrivateDNSZone{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *PrivateDNSZone) ValidateCreate() error {
+	privatednszonelog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("PrivateDNSZone").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *PrivateDNSZone) ValidateUpdate(old runtime.Object) error {
+	privatednszonelog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("PrivateDNSZone").GroupKind(), old, r, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *PrivateDNSZone) ValidateDelete() error {
+	privatednszonelog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("PrivateDNSZone").GroupKind(), r)
+}
+
+// log is for logging in this package.
+var publicipaddresslog = logf.Log.WithName("publicipaddress-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-network-infra-azure-com-v1-publicipaddress,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=publicipaddresss,verbs=create;update,versions=v1,name=default.publicipaddress.infra.azure.com
+
+var _ webhook.Defaulter = &PublicIPAddress{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *PublicIPAddress) Default() {
+	publicipaddresslog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-publicipaddress,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=publicipaddresss,versions=v1,name=validation.publicipaddress.infra.azure.com
+
+var _ webhook.Validator = &PublicIPAddress{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *PublicIPAddress) ValidateCreate() error {
+	publicipaddresslog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("PublicIPAddress").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *PublicIPAddress) ValidateUpdate(old runtime.Object) error {
+	publicipaddresslog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("PublicIPAddress").GroupKind(), old, r, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *PublicIPAddress) ValidateDelete() error {
+	publicipaddresslog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("PublicIPAddress").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -383,11 +512,13 @@ var _ webhook.Defaulter = &Route{}
 func (r *Route) Default() {
 	routelog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-route,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=routes,versions=v1,name=validation.route.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-route,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=routes,versions=v1,name=validation.route.infra.azure.com
 
 var _ webhook.Validator = &Route{}
 
@@ -395,24 +526,21 @@ var _ webhook.Validator = &Route{}
 func (r *Route) ValidateCreate() error {
 	routelog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("Route").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *Route) ValidateUpdate(old runtime.Object) error {
 	routelog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("Route").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *Route) ValidateDelete() error {
 	routelog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("Route").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -426,11 +554,15 @@ var _ webhook.Defaulter = &RouteTable{}
 func (r *RouteTable) Default() {
 	routetablelog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-routetable,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=routetables,versions=v1,name=validation.routetable.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-routetable,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=routetables,versions=v1,name=validation.routetable.infra.azure.com
 
 var _ webhook.Validator = &RouteTable{}
 
@@ -438,24 +570,21 @@ var _ webhook.Validator = &RouteTable{}
 func (r *RouteTable) ValidateCreate() error {
 	routetablelog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("RouteTable").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *RouteTable) ValidateUpdate(old runtime.Object) error {
 	routetablelog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("RouteTable").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *RouteTable) ValidateDelete() error {
 	routetablelog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("RouteTable").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -469,11 +598,13 @@ var _ webhook.Defaulter = &SecurityRule{}
 func (r *SecurityRule) Default() {
 	securityrulelog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-securityrule,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=securityrules,versions=v1,name=validation.securityrule.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-securityrule,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=securityrules,versions=v1,name=validation.securityrule.infra.azure.com
 
 var _ webhook.Validator = &SecurityRule{}
 
@@ -481,24 +612,21 @@ var _ webhook.Validator = &SecurityRule{}
 func (r *SecurityRule) ValidateCreate() error {
 	securityrulelog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("SecurityRule").GroupKind(), r.Name, r.validateSpec())
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *SecurityRule) ValidateUpdate(old runtime.Object) error {
 	securityrulelog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("SecurityRule").GroupKind(), old, r, r.validateSpec())
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *SecurityRule) ValidateDelete() error {
 	securityrulelog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("SecurityRule").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -512,11 +640,15 @@ var _ webhook.Defaulter = &VirtualNetwork{}
 func (r *VirtualNetwork) Default() {
 	virtualnetworklog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-virtualnetwork,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=virtualnetworks,versions=v1,name=validation.virtualnetwork.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-virtualnetwork,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=virtualnetworks,versions=v1,name=validation.virtualnetwork.infra.azure.com
 
 var _ webhook.Validator = &VirtualNetwork{}
 
@@ -524,24 +656,21 @@ var _ webhook.Validator = &VirtualNetwork{}
 func (r *VirtualNetwork) ValidateCreate() error {
 	virtualnetworklog.Info("validate create", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object creation.
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("VirtualNetwork").GroupKind(), r.Name, append(r.validateSpec(), r.validatePeeredAddressSpaces()...))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *VirtualNetwork) ValidateUpdate(old runtime.Object) error {
 	virtualnetworklog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("VirtualNetwork").GroupKind(), old, r, append(append(r.validateSpec(), r.validateSubnetsWithinAddressSpace()...), r.validatePeeredAddressSpaces()...))
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *VirtualNetwork) ValidateDelete() error {
 	virtualnetworklog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("VirtualNetwork").GroupKind(), r)
 }
 
 // log is for logging in this package.
@@ -554,26 +683,117 @@ var _ webhook.Defaulter = &Subnet{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *Subnet) Default() {
 	subnetlog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
 }
 
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-network-infra-azure-com-v1-subnet,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=subnets,versions=v1,name=validation.subnet.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-subnet,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=subnets,versions=v1,name=validation.subnet.infra.azure.com
 
 var _ webhook.Validator = &Subnet{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *Subnet) ValidateCreate() error {
 	subnetlog.Info("validate create", "name", r.Name)
-	return nil
+	return azcorev1.InvalidError(GroupVersion.WithKind("Subnet").GroupKind(), r.Name, append(r.validateSpec(), r.validateAddressSpace()...))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *Subnet) ValidateUpdate(old runtime.Object) error {
 	subnetlog.Info("validate update", "name", r.Name)
-	return nil
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("Subnet").GroupKind(), old, r, append(r.validateSpec(), r.validateAddressSpace()...))
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (r *Subnet) ValidateDelete() error {
 	subnetlog.Info("validate delete", "name", r.Name)
-	return nil
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("Subnet").GroupKind(), r)
+}
+
+// log is for logging in this package.
+var virtualnetworklinklog = logf.Log.WithName("virtualnetworklink-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-network-infra-azure-com-v1-virtualnetworklink,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=virtualnetworklinks,verbs=create;update,versions=v1,name=default.virtualnetworklink.infra.azure.com
+
+var _ webhook.Defaulter = &VirtualNetworkLink{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *VirtualNetworkLink) Default() {
+	virtualnetworklinklog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultPrivateDNSAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-virtualnetworklink,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=virtualnetworklinks,versions=v1,name=validation.virtualnetworklink.infra.azure.com
+
+var _ webhook.Validator = &VirtualNetworkLink{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualNetworkLink) ValidateCreate() error {
+	virtualnetworklinklog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("VirtualNetworkLink").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualNetworkLink) ValidateUpdate(old runtime.Object) error {
+	virtualnetworklinklog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("VirtualNetworkLink").GroupKind(), old, r, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualNetworkLink) ValidateDelete() error {
+	virtualnetworklinklog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("VirtualNetworkLink").GroupKind(), r)
+}
+
+// log is for logging in this package.
+var virtualnetworkpeeringlog = logf.Log.WithName("virtualnetworkpeering-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-network-infra-azure-com-v1-virtualnetworkpeering,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=virtualnetworkpeerings,verbs=create;update,versions=v1,name=default.virtualnetworkpeering.infra.azure.com
+
+var _ webhook.Defaulter = &VirtualNetworkPeering{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *VirtualNetworkPeering) Default() {
+	virtualnetworkpeeringlog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-network-infra-azure-com-v1-virtualnetworkpeering,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.network.infra.azure.com,resources=virtualnetworkpeerings,versions=v1,name=validation.virtualnetworkpeering.infra.azure.com
+
+var _ webhook.Validator = &VirtualNetworkPeering{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualNetworkPeering) ValidateCreate() error {
+	virtualnetworkpeeringlog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("VirtualNetworkPeering").GroupKind(), r.Name, append(r.validateSpec(), r.validateAddressSpace()...))
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualNetworkPeering) ValidateUpdate(old runtime.Object) error {
+	virtualnetworkpeeringlog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("VirtualNetworkPeering").GroupKind(), old, r, append(r.validateSpec(), r.validateAddressSpace()...))
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VirtualNetworkPeering) ValidateDelete() error {
+	virtualnetworkpeeringlog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("VirtualNetworkPeering").GroupKind(), r)
 }
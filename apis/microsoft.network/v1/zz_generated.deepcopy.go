@@ -55,7 +55,7 @@ func (in *BackendAddressPool) DeepCopyInto(out *BackendAddressPool) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendAddressPool.
@@ -116,6 +116,11 @@ func (in *BackendAddressPoolSpec) DeepCopyInto(out *BackendAddressPoolSpec) {
 		*out = new(BackendAddressPoolSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendAddressPoolSpec.
@@ -161,6 +166,13 @@ func (in *BackendAddressPoolSpecProperties) DeepCopy() *BackendAddressPoolSpecPr
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendAddressPoolStatus) DeepCopyInto(out *BackendAddressPoolStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendAddressPoolStatus.
@@ -199,7 +211,7 @@ func (in *FrontendIPConfiguration) DeepCopyInto(out *FrontendIPConfiguration) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendIPConfiguration.
@@ -260,6 +272,11 @@ func (in *FrontendIPConfigurationSpec) DeepCopyInto(out *FrontendIPConfiguration
 		*out = new(FrontendIPConfigurationSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendIPConfigurationSpec.
@@ -305,6 +322,13 @@ func (in *FrontendIPConfigurationSpecProperties) DeepCopy() *FrontendIPConfigura
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FrontendIPConfigurationStatus) DeepCopyInto(out *FrontendIPConfigurationStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendIPConfigurationStatus.
@@ -323,7 +347,7 @@ func (in *InboundNatRule) DeepCopyInto(out *InboundNatRule) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InboundNatRule.
@@ -384,6 +408,11 @@ func (in *InboundNatRuleSpec) DeepCopyInto(out *InboundNatRuleSpec) {
 		*out = new(InboundNatRuleSpecProperties)
 		**out = **in
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InboundNatRuleSpec.
@@ -414,6 +443,13 @@ func (in *InboundNatRuleSpecProperties) DeepCopy() *InboundNatRuleSpecProperties
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InboundNatRuleStatus) DeepCopyInto(out *InboundNatRuleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InboundNatRuleStatus.
@@ -432,7 +468,7 @@ func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
@@ -525,6 +561,11 @@ func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 		*out = new(LoadBalancerSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
@@ -575,6 +616,13 @@ func (in *LoadBalancerSpecProperties) DeepCopy() *LoadBalancerSpecProperties {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStatus.
@@ -593,7 +641,7 @@ func (in *LoadBalancingRule) DeepCopyInto(out *LoadBalancingRule) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingRule.
@@ -654,6 +702,11 @@ func (in *LoadBalancingRuleSpec) DeepCopyInto(out *LoadBalancingRuleSpec) {
 		*out = new(LoadBalancerRuleSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingRuleSpec.
@@ -669,6 +722,13 @@ func (in *LoadBalancingRuleSpec) DeepCopy() *LoadBalancingRuleSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancingRuleStatus) DeepCopyInto(out *LoadBalancingRuleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingRuleStatus.
@@ -681,13 +741,60 @@ func (in *LoadBalancingRuleStatus) DeepCopy() *LoadBalancingRuleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceDNSSettings) DeepCopyInto(out *NetworkInterfaceDNSSettings) {
+	*out = *in
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceDNSSettings.
+func (in *NetworkInterfaceDNSSettings) DeepCopy() *NetworkInterfaceDNSSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceDNSSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfaceIPConfiguration) DeepCopyInto(out *NetworkInterfaceIPConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceIPConfiguration.
@@ -748,6 +855,11 @@ func (in *NetworkInterfaceIPConfigurationSpec) DeepCopyInto(out *NetworkInterfac
 		*out = new(NetworkInterfaceIPConfigurationSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceIPConfigurationSpec.
@@ -788,6 +900,13 @@ func (in *NetworkInterfaceIPConfigurationSpecProperties) DeepCopy() *NetworkInte
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfaceIPConfigurationStatus) DeepCopyInto(out *NetworkInterfaceIPConfigurationStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceIPConfigurationStatus.
@@ -800,13 +919,139 @@ func (in *NetworkInterfaceIPConfigurationStatus) DeepCopy() *NetworkInterfaceIPC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceList) DeepCopyInto(out *NetworkInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceList.
+func (in *NetworkInterfaceList) DeepCopy() *NetworkInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
+	*out = *in
+	if in.ResourceGroupRef != nil {
+		in, out := &in.ResourceGroupRef, &out.ResourceGroupRef
+		*out = new(corev1.KnownTypeReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(NetworkInterfaceSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpec.
+func (in *NetworkInterfaceSpec) DeepCopy() *NetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpecProperties) DeepCopyInto(out *NetworkInterfaceSpecProperties) {
+	*out = *in
+	if in.DNSSettings != nil {
+		in, out := &in.DNSSettings, &out.DNSSettings
+		*out = new(NetworkInterfaceDNSSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPConfigurationRefs != nil {
+		in, out := &in.IPConfigurationRefs, &out.IPConfigurationRefs
+		*out = make([]corev1.KnownTypeReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkSecurityGroupRef != nil {
+		in, out := &in.NetworkSecurityGroupRef, &out.NetworkSecurityGroupRef
+		*out = new(corev1.KnownTypeReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpecProperties.
+func (in *NetworkInterfaceSpecProperties) DeepCopy() *NetworkInterfaceSpecProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpecProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
+	*out = *in
+	if in.VirtualMachine != nil {
+		in, out := &in.VirtualMachine, &out.VirtualMachine
+		*out = new(corev1.ARMIDReference)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceStatus.
+func (in *NetworkInterfaceStatus) DeepCopy() *NetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSecurityGroup) DeepCopyInto(out *NetworkSecurityGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSecurityGroup.
@@ -879,6 +1124,11 @@ func (in *NetworkSecurityGroupSpec) DeepCopyInto(out *NetworkSecurityGroupSpec)
 		*out = new(NetworkSecurityGroupSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSecurityGroupSpec.
@@ -914,6 +1164,13 @@ func (in *NetworkSecurityGroupSpecProperties) DeepCopy() *NetworkSecurityGroupSp
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSecurityGroupStatus) DeepCopyInto(out *NetworkSecurityGroupStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSecurityGroupStatus.
@@ -932,7 +1189,7 @@ func (in *OutboundRule) DeepCopyInto(out *OutboundRule) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundRule.
@@ -993,6 +1250,11 @@ func (in *OutboundRuleSpec) DeepCopyInto(out *OutboundRuleSpec) {
 		*out = new(OutboundRuleSpecProperties)
 		**out = **in
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundRuleSpec.
@@ -1023,6 +1285,13 @@ func (in *OutboundRuleSpecProperties) DeepCopy() *OutboundRuleSpecProperties {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutboundRuleStatus) DeepCopyInto(out *OutboundRuleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundRuleStatus.
@@ -1036,26 +1305,26 @@ func (in *OutboundRuleStatus) DeepCopy() *OutboundRuleStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route) DeepCopyInto(out *Route) {
+func (in *PrivateDNSZone) DeepCopyInto(out *PrivateDNSZone) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
-func (in *Route) DeepCopy() *Route {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZone.
+func (in *PrivateDNSZone) DeepCopy() *PrivateDNSZone {
 	if in == nil {
 		return nil
 	}
-	out := new(Route)
+	out := new(PrivateDNSZone)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Route) DeepCopyObject() runtime.Object {
+func (in *PrivateDNSZone) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1063,31 +1332,31 @@ func (in *Route) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteList) DeepCopyInto(out *RouteList) {
+func (in *PrivateDNSZoneList) DeepCopyInto(out *PrivateDNSZoneList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Route, len(*in))
+		*out = make([]PrivateDNSZone, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
-func (in *RouteList) DeepCopy() *RouteList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZoneList.
+func (in *PrivateDNSZoneList) DeepCopy() *PrivateDNSZoneList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteList)
+	out := new(PrivateDNSZoneList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteList) DeepCopyObject() runtime.Object {
+func (in *PrivateDNSZoneList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1095,76 +1364,105 @@ func (in *RouteList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+func (in *PrivateDNSZoneSpec) DeepCopyInto(out *PrivateDNSZoneSpec) {
 	*out = *in
+	if in.ResourceGroupRef != nil {
+		in, out := &in.ResourceGroupRef, &out.ResourceGroupRef
+		*out = new(corev1.KnownTypeReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
-		*out = new(RouteSpecProperties)
-		**out = **in
+		*out = new(PrivateDNSZoneSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
-func (in *RouteSpec) DeepCopy() *RouteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZoneSpec.
+func (in *PrivateDNSZoneSpec) DeepCopy() *PrivateDNSZoneSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteSpec)
+	out := new(PrivateDNSZoneSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteSpecProperties) DeepCopyInto(out *RouteSpecProperties) {
+func (in *PrivateDNSZoneSpecProperties) DeepCopyInto(out *PrivateDNSZoneSpecProperties) {
 	*out = *in
+	if in.VirtualNetworkLinkRefs != nil {
+		in, out := &in.VirtualNetworkLinkRefs, &out.VirtualNetworkLinkRefs
+		*out = make([]corev1.KnownTypeReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpecProperties.
-func (in *RouteSpecProperties) DeepCopy() *RouteSpecProperties {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZoneSpecProperties.
+func (in *PrivateDNSZoneSpecProperties) DeepCopy() *PrivateDNSZoneSpecProperties {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteSpecProperties)
+	out := new(PrivateDNSZoneSpecProperties)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
+func (in *PrivateDNSZoneStatus) DeepCopyInto(out *PrivateDNSZoneStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
-func (in *RouteStatus) DeepCopy() *RouteStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZoneStatus.
+func (in *PrivateDNSZoneStatus) DeepCopy() *PrivateDNSZoneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteStatus)
+	out := new(PrivateDNSZoneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+func (in *PublicIPAddress) DeepCopyInto(out *PublicIPAddress) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
-func (in *RouteTable) DeepCopy() *RouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddress.
+func (in *PublicIPAddress) DeepCopy() *PublicIPAddress {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTable)
+	out := new(PublicIPAddress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteTable) DeepCopyObject() runtime.Object {
+func (in *PublicIPAddress) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1172,31 +1470,46 @@ func (in *RouteTable) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableList) DeepCopyInto(out *RouteTableList) {
+func (in *PublicIPAddressDNSSettings) DeepCopyInto(out *PublicIPAddressDNSSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressDNSSettings.
+func (in *PublicIPAddressDNSSettings) DeepCopy() *PublicIPAddressDNSSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressDNSSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressList) DeepCopyInto(out *PublicIPAddressList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RouteTable, len(*in))
+		*out = make([]PublicIPAddress, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableList.
-func (in *RouteTableList) DeepCopy() *RouteTableList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressList.
+func (in *PublicIPAddressList) DeepCopy() *PublicIPAddressList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableList)
+	out := new(PublicIPAddressList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteTableList) DeepCopyObject() runtime.Object {
+func (in *PublicIPAddressList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1204,13 +1517,18 @@ func (in *RouteTableList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
+func (in *PublicIPAddressSpec) DeepCopyInto(out *PublicIPAddressSpec) {
 	*out = *in
 	if in.ResourceGroupRef != nil {
 		in, out := &in.ResourceGroupRef, &out.ResourceGroupRef
 		*out = new(corev1.KnownTypeReference)
 		**out = **in
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -1220,13 +1538,277 @@ func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 	}
 	if in.Properties != nil {
 		in, out := &in.Properties, &out.Properties
-		*out = new(RouteTableSpecProperties)
+		*out = new(PublicIPAddressSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
-func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressSpec.
+func (in *PublicIPAddressSpec) DeepCopy() *PublicIPAddressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressSpecProperties) DeepCopyInto(out *PublicIPAddressSpecProperties) {
+	*out = *in
+	if in.DNSSettings != nil {
+		in, out := &in.DNSSettings, &out.DNSSettings
+		*out = new(PublicIPAddressDNSSettings)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressSpecProperties.
+func (in *PublicIPAddressSpecProperties) DeepCopy() *PublicIPAddressSpecProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressSpecProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressStatus) DeepCopyInto(out *PublicIPAddressStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressStatus.
+func (in *PublicIPAddressStatus) DeepCopy() *PublicIPAddressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteList) DeepCopyInto(out *RouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteList.
+func (in *RouteList) DeepCopy() *RouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(RouteSpecProperties)
+		**out = **in
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
+func (in *RouteSpec) DeepCopy() *RouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSpecProperties) DeepCopyInto(out *RouteSpecProperties) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpecProperties.
+func (in *RouteSpecProperties) DeepCopy() *RouteSpecProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSpecProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteStatus.
+func (in *RouteStatus) DeepCopy() *RouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
+func (in *RouteTable) DeepCopy() *RouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteTable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableList) DeepCopyInto(out *RouteTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouteTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableList.
+func (in *RouteTableList) DeepCopy() *RouteTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
+	*out = *in
+	if in.ResourceGroupRef != nil {
+		in, out := &in.ResourceGroupRef, &out.ResourceGroupRef
+		*out = new(corev1.KnownTypeReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(RouteTableSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
+func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
 	if in == nil {
 		return nil
 	}
@@ -1258,6 +1840,13 @@ func (in *RouteTableSpecProperties) DeepCopy() *RouteTableSpecProperties {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableStatus.
@@ -1276,7 +1865,7 @@ func (in *SecurityRule) DeepCopyInto(out *SecurityRule) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRule.
@@ -1337,6 +1926,11 @@ func (in *SecurityRuleSpec) DeepCopyInto(out *SecurityRuleSpec) {
 		*out = new(SecurityRuleSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRuleSpec.
@@ -1377,6 +1971,13 @@ func (in *SecurityRuleSpecProperties) DeepCopy() *SecurityRuleSpecProperties {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityRuleStatus) DeepCopyInto(out *SecurityRuleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRuleStatus.
@@ -1395,7 +1996,7 @@ func (in *Subnet) DeepCopyInto(out *Subnet) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
@@ -1416,6 +2017,21 @@ func (in *Subnet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetAllocation) DeepCopyInto(out *SubnetAllocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetAllocation.
+func (in *SubnetAllocation) DeepCopy() *SubnetAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetList) DeepCopyInto(out *SubnetList) {
 	*out = *in
@@ -1472,6 +2088,11 @@ func (in *SubnetProperties) DeepCopy() *SubnetProperties {
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
 	in.Properties.DeepCopyInto(&out.Properties)
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
@@ -1487,6 +2108,18 @@ func (in *SubnetSpec) DeepCopy() *SubnetSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 	*out = *in
+	if in.IPConfigurations != nil {
+		in, out := &in.IPConfigurations, &out.IPConfigurations
+		*out = make([]corev1.ARMIDReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
@@ -1505,7 +2138,7 @@ func (in *VirtualNetwork) DeepCopyInto(out *VirtualNetwork) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetwork.
@@ -1526,6 +2159,139 @@ func (in *VirtualNetwork) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkLink) DeepCopyInto(out *VirtualNetworkLink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkLink.
+func (in *VirtualNetworkLink) DeepCopy() *VirtualNetworkLink {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualNetworkLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkLinkList) DeepCopyInto(out *VirtualNetworkLinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualNetworkLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkLinkList.
+func (in *VirtualNetworkLinkList) DeepCopy() *VirtualNetworkLinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkLinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualNetworkLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkLinkSpec) DeepCopyInto(out *VirtualNetworkLinkSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(VirtualNetworkLinkSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkLinkSpec.
+func (in *VirtualNetworkLinkSpec) DeepCopy() *VirtualNetworkLinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkLinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkLinkSpecProperties) DeepCopyInto(out *VirtualNetworkLinkSpecProperties) {
+	*out = *in
+	if in.VirtualNetworkRef != nil {
+		in, out := &in.VirtualNetworkRef, &out.VirtualNetworkRef
+		*out = new(corev1.KnownTypeReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkLinkSpecProperties.
+func (in *VirtualNetworkLinkSpecProperties) DeepCopy() *VirtualNetworkLinkSpecProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkLinkSpecProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkLinkStatus) DeepCopyInto(out *VirtualNetworkLinkStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkLinkStatus.
+func (in *VirtualNetworkLinkStatus) DeepCopy() *VirtualNetworkLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualNetworkList) DeepCopyInto(out *VirtualNetworkList) {
 	*out = *in
@@ -1558,6 +2324,137 @@ func (in *VirtualNetworkList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeering) DeepCopyInto(out *VirtualNetworkPeering) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeering.
+func (in *VirtualNetworkPeering) DeepCopy() *VirtualNetworkPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualNetworkPeering) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeeringList) DeepCopyInto(out *VirtualNetworkPeeringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualNetworkPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeeringList.
+func (in *VirtualNetworkPeeringList) DeepCopy() *VirtualNetworkPeeringList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeeringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualNetworkPeeringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeeringSpec) DeepCopyInto(out *VirtualNetworkPeeringSpec) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(VirtualNetworkPeeringSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeeringSpec.
+func (in *VirtualNetworkPeeringSpec) DeepCopy() *VirtualNetworkPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeeringSpecProperties) DeepCopyInto(out *VirtualNetworkPeeringSpecProperties) {
+	*out = *in
+	if in.AllowVirtualNetworkAccess != nil {
+		in, out := &in.AllowVirtualNetworkAccess, &out.AllowVirtualNetworkAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoteVirtualNetworkRef != nil {
+		in, out := &in.RemoteVirtualNetworkRef, &out.RemoteVirtualNetworkRef
+		*out = new(corev1.KnownTypeReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeeringSpecProperties.
+func (in *VirtualNetworkPeeringSpecProperties) DeepCopy() *VirtualNetworkPeeringSpecProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeeringSpecProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeeringStatus) DeepCopyInto(out *VirtualNetworkPeeringStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeeringStatus.
+func (in *VirtualNetworkPeeringStatus) DeepCopy() *VirtualNetworkPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeeringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualNetworkSpec) DeepCopyInto(out *VirtualNetworkSpec) {
 	*out = *in
@@ -1578,6 +2475,11 @@ func (in *VirtualNetworkSpec) DeepCopyInto(out *VirtualNetworkSpec) {
 		*out = new(VirtualNetworkSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkSpec.
@@ -1613,6 +2515,11 @@ func (in *VirtualNetworkSpecProperties) DeepCopyInto(out *VirtualNetworkSpecProp
 		*out = make([]corev1.KnownTypeReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.VirtualNetworkPeeringRefs != nil {
+		in, out := &in.VirtualNetworkPeeringRefs, &out.VirtualNetworkPeeringRefs
+		*out = make([]corev1.KnownTypeReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkSpecProperties.
@@ -1628,6 +2535,18 @@ func (in *VirtualNetworkSpecProperties) DeepCopy() *VirtualNetworkSpecProperties
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualNetworkStatus) DeepCopyInto(out *VirtualNetworkStatus) {
 	*out = *in
+	if in.SubnetAllocations != nil {
+		in, out := &in.SubnetAllocations, &out.SubnetAllocations
+		*out = make([]SubnetAllocation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(corev1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkStatus.
@@ -0,0 +1,69 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20180901
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.network/v1"
+)
+
+const (
+	apiVersion = "2018-09-01"
+)
+
+func (src *PrivateDNSZone) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.PrivateDNSZone)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20180901_PrivateDNSZone_To_v1_PrivateDNSZone(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
+	return nil
+}
+
+func (dst *PrivateDNSZone) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.PrivateDNSZone)
+
+	if err := Convert_v1_PrivateDNSZone_To_v20180901_PrivateDNSZone(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
+
+func (src *VirtualNetworkLink) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.VirtualNetworkLink)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20180901_VirtualNetworkLink_To_v1_VirtualNetworkLink(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
+	return nil
+}
+
+func (dst *VirtualNetworkLink) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.VirtualNetworkLink)
+
+	if err := Convert_v1_VirtualNetworkLink_To_v20180901_VirtualNetworkLink(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
@@ -0,0 +1,33 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20180901
+
+import (
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.network/v1"
+	"github.com/Azure/k8s-infra/internal/test"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Hub   conversion.Hub
+		Spoke conversion.Convertible
+	}{
+		{Name: "PrivateDNSZone", Hub: &v1.PrivateDNSZone{}, Spoke: &PrivateDNSZone{}},
+		{Name: "VirtualNetworkLink", Hub: &v1.VirtualNetworkLink{}, Spoke: &VirtualNetworkLink{}},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			test.FuzzConversion(t, c.Hub, c.Spoke)
+		})
+	}
+}
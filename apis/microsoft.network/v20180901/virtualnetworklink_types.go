@@ -0,0 +1,76 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20180901
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// VirtualNetworkLinkSpecProperties are the resource specific properties
+	VirtualNetworkLinkSpecProperties struct {
+		// RegistrationEnabled automatically registers records in the zone for the VMs of the virtual network
+		// +optional
+		RegistrationEnabled bool `json:"registrationEnabled,omitempty"`
+
+		// VirtualNetworkRef is the VirtualNetwork linked to the zone. Either virtualNetworkRef or virtualNetworkId is
+		// required.
+		// +optional
+		VirtualNetworkRef *azcorev1.KnownTypeReference `json:"virtualNetworkRef,omitempty"`
+
+		// VirtualNetworkID is the ARM ID of the virtual network linked to the zone, for a virtual network which is not
+		// managed within the cluster
+		// +optional
+		VirtualNetworkID string `json:"virtualNetworkId,omitempty"`
+	}
+
+	// VirtualNetworkLinkSpec defines the desired state of VirtualNetworkLink
+	VirtualNetworkLinkSpec struct {
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the VirtualNetworkLink
+		// +kubebuilder:validation:Required
+		Properties *VirtualNetworkLinkSpecProperties `json:"properties"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// VirtualNetworkLinkStatus defines the observed state of VirtualNetworkLink
+	VirtualNetworkLinkStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VirtualNetworkLink is the Schema for the virtualnetworklinks API
+	VirtualNetworkLink struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   VirtualNetworkLinkSpec   `json:"spec,omitempty"`
+		Status VirtualNetworkLinkStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VirtualNetworkLinkList contains a list of VirtualNetworkLink
+	VirtualNetworkLinkList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []VirtualNetworkLink `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&VirtualNetworkLink{}, &VirtualNetworkLinkList{})
+}
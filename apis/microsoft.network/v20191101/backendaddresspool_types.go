@@ -20,6 +20,10 @@ type (
 
 	BackendAddressPoolSpec struct {
 		Properties *BackendAddressPoolSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// BackendAddressPoolStatus defines the observed state of BackendAddressPool
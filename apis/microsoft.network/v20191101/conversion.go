@@ -6,10 +6,9 @@ Licensed under the MIT license.
 package v20191101
 
 import (
-	"fmt"
-
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 	v1 "github.com/Azure/k8s-infra/apis/microsoft.network/v1"
 )
 
@@ -20,11 +19,16 @@ const (
 func (src *BackendAddressPool) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.BackendAddressPool)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_BackendAddressPool_To_v1_BackendAddressPool(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -35,17 +39,22 @@ func (dst *BackendAddressPool) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *FrontendIPConfiguration) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.FrontendIPConfiguration)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_FrontendIPConfiguration_To_v1_FrontendIPConfiguration(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -56,17 +65,22 @@ func (dst *FrontendIPConfiguration) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *InboundNatRule) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.InboundNatRule)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_InboundNatRule_To_v1_InboundNatRule(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -77,17 +91,22 @@ func (dst *InboundNatRule) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *LoadBalancer) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.LoadBalancer)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_LoadBalancer_To_v1_LoadBalancer(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -98,17 +117,22 @@ func (dst *LoadBalancer) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *LoadBalancingRule) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.LoadBalancingRule)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_LoadBalancingRule_To_v1_LoadBalancingRule(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -119,17 +143,48 @@ func (dst *LoadBalancingRule) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
+	return azcorev1.StoreConversionData(src, dst)
+}
+
+func (src *NetworkInterface) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.NetworkInterface)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20191101_NetworkInterface_To_v1_NetworkInterface(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
+func (dst *NetworkInterface) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.NetworkInterface)
+
+	if err := Convert_v1_NetworkInterface_To_v20191101_NetworkInterface(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
+
 func (src *NetworkInterfaceIPConfiguration) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.NetworkInterfaceIPConfiguration)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_NetworkInterfaceIPConfiguration_To_v1_NetworkInterfaceIPConfiguration(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -140,17 +195,22 @@ func (dst *NetworkInterfaceIPConfiguration) ConvertFrom(srcRaw conversion.Hub) e
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *NetworkSecurityGroup) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.NetworkSecurityGroup)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_NetworkSecurityGroup_To_v1_NetworkSecurityGroup(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -161,17 +221,22 @@ func (dst *NetworkSecurityGroup) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *OutboundRule) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.OutboundRule)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_OutboundRule_To_v1_OutboundRule(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -182,17 +247,48 @@ func (dst *OutboundRule) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
+	return azcorev1.StoreConversionData(src, dst)
+}
+
+func (src *PublicIPAddress) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.PublicIPAddress)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20191101_PublicIPAddress_To_v1_PublicIPAddress(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
+func (dst *PublicIPAddress) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.PublicIPAddress)
+
+	if err := Convert_v1_PublicIPAddress_To_v20191101_PublicIPAddress(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
+
 func (src *Route) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.Route)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_Route_To_v1_Route(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -203,17 +299,22 @@ func (dst *Route) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *RouteTable) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.RouteTable)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_RouteTable_To_v1_RouteTable(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -224,17 +325,22 @@ func (dst *RouteTable) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *SecurityRule) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.SecurityRule)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_SecurityRule_To_v1_SecurityRule(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -245,17 +351,22 @@ func (dst *SecurityRule) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *Subnet) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.Subnet)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_Subnet_To_v1_Subnet(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -266,18 +377,22 @@ func (dst *Subnet) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 func (src *VirtualNetwork) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.VirtualNetwork)
 
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191101_VirtualNetwork_To_v1_VirtualNetwork(src, dst, nil); err != nil {
-		fmt.Println(err)
 		return err
 	}
 
-	dst.Spec.APIVersion = apiVersion
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -285,9 +400,34 @@ func (dst *VirtualNetwork) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*v1.VirtualNetwork)
 
 	if err := Convert_v1_VirtualNetwork_To_v20191101_VirtualNetwork(src, dst, nil); err != nil {
-		fmt.Println(err)
 		return err
 	}
 
+	return azcorev1.StoreConversionData(src, dst)
+}
+
+func (src *VirtualNetworkPeering) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.VirtualNetworkPeering)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20191101_VirtualNetworkPeering_To_v1_VirtualNetworkPeering(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
+
+func (dst *VirtualNetworkPeering) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.VirtualNetworkPeering)
+
+	if err := Convert_v1_VirtualNetworkPeering_To_v20191101_VirtualNetworkPeering(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20191101
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.network/v1"
+	"github.com/Azure/k8s-infra/internal/test"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Hub   conversion.Hub
+		Spoke conversion.Convertible
+	}{
+		{Name: "BackendAddressPool", Hub: &v1.BackendAddressPool{}, Spoke: &BackendAddressPool{}},
+		{Name: "FrontendIPConfiguration", Hub: &v1.FrontendIPConfiguration{}, Spoke: &FrontendIPConfiguration{}},
+		{Name: "InboundNatRule", Hub: &v1.InboundNatRule{}, Spoke: &InboundNatRule{}},
+		{Name: "LoadBalancer", Hub: &v1.LoadBalancer{}, Spoke: &LoadBalancer{}},
+		{Name: "LoadBalancingRule", Hub: &v1.LoadBalancingRule{}, Spoke: &LoadBalancingRule{}},
+		{Name: "NetworkInterface", Hub: &v1.NetworkInterface{}, Spoke: &NetworkInterface{}},
+		{Name: "NetworkInterfaceIPConfiguration", Hub: &v1.NetworkInterfaceIPConfiguration{}, Spoke: &NetworkInterfaceIPConfiguration{}},
+		{Name: "NetworkSecurityGroup", Hub: &v1.NetworkSecurityGroup{}, Spoke: &NetworkSecurityGroup{}},
+		{Name: "OutboundRule", Hub: &v1.OutboundRule{}, Spoke: &OutboundRule{}},
+		{Name: "PublicIPAddress", Hub: &v1.PublicIPAddress{}, Spoke: &PublicIPAddress{}},
+		{Name: "Route", Hub: &v1.Route{}, Spoke: &Route{}},
+		{Name: "RouteTable", Hub: &v1.RouteTable{}, Spoke: &RouteTable{}},
+		{Name: "SecurityRule", Hub: &v1.SecurityRule{}, Spoke: &SecurityRule{}},
+		{Name: "Subnet", Hub: &v1.Subnet{}, Spoke: &Subnet{}},
+		{Name: "VirtualNetwork", Hub: &v1.VirtualNetwork{}, Spoke: &VirtualNetwork{}},
+		{Name: "VirtualNetworkPeering", Hub: &v1.VirtualNetworkPeering{}, Spoke: &VirtualNetworkPeering{}},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			test.FuzzConversion(t, c.Hub, c.Spoke)
+		})
+	}
+}
+
+func TestSubnet_ConversionData(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	hub := &v1.Subnet{
+		Spec: v1.SubnetSpec{
+			APIVersion:          "2019-11-01",
+			Properties:          v1.SubnetProperties{AddressPrefix: "10.0.1.0/24"},
+			AddressPrefixLength: 24,
+		},
+		Status: v1.SubnetStatus{
+			ID:                "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet",
+			ProvisioningState: "Succeeded",
+			ETag:              "etag",
+		},
+	}
+
+	// only the fields this version can not represent are stored
+	spoke := &Subnet{}
+	g.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+	g.Expect(spoke.GetAnnotations()).To(gomega.HaveKeyWithValue(azcorev1.ConversionDataAnnotation,
+		`{"spec":{"addressPrefixLength":24},"status":{"etag":"etag"}}`))
+
+	restored := &v1.Subnet{}
+	g.Expect(spoke.ConvertTo(restored)).To(gomega.Succeed())
+	g.Expect(restored).To(gomega.Equal(hub))
+
+	// nothing is stored when this version represents the hub in full
+	hub.Spec.AddressPrefixLength = 0
+	hub.Status.ETag = ""
+	spoke = &Subnet{}
+	g.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+	g.Expect(spoke.GetAnnotations()).NotTo(gomega.HaveKey(azcorev1.ConversionDataAnnotation))
+}
@@ -27,6 +27,10 @@ type (
 	FrontendIPConfigurationSpec struct {
 		// Properties of the Virtual Network
 		Properties *FrontendIPConfigurationSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// FrontendIPConfigurationStatus defines the observed state of FrontendIPConfiguration
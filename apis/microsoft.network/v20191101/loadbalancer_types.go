@@ -38,6 +38,10 @@ type (
 
 		// Properties of the Virtual Network
 		Properties *LoadBalancerSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// LoadBalancerStatus defines the observed state of LoadBalancer
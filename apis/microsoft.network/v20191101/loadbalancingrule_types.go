@@ -29,6 +29,10 @@ type (
 	// LoadBalancingRuleSpec defines the desired state of LoadBalancingRule
 	LoadBalancingRuleSpec struct {
 		Properties *LoadBalancerRuleSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// LoadBalancingRuleStatus defines the observed state of LoadBalancingRule
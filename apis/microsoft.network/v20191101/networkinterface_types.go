@@ -0,0 +1,81 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20191101
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// NetworkInterfaceDNSSettings are the DNS settings of the network interface
+	NetworkInterfaceDNSSettings struct {
+		DNSServers           []string `json:"dnsServers,omitempty"`
+		InternalDNSNameLabel string   `json:"internalDnsNameLabel,omitempty"`
+	}
+
+	// NetworkInterfaceSpecProperties are the resource specific properties
+	NetworkInterfaceSpecProperties struct {
+		DNSSettings                 *NetworkInterfaceDNSSettings  `json:"dnsSettings,omitempty"`
+		EnableAcceleratedNetworking bool                          `json:"enableAcceleratedNetworking,omitempty"`
+		EnableIPForwarding          bool                          `json:"enableIPForwarding,omitempty"`
+		IPConfigurationRefs         []azcorev1.KnownTypeReference `json:"ipConfigurationRefs,omitempty"`
+		NetworkSecurityGroupRef     *azcorev1.KnownTypeReference  `json:"networkSecurityGroupRef,omitempty"`
+	}
+
+	// NetworkInterfaceSpec defines the desired state of NetworkInterface
+	NetworkInterfaceSpec struct {
+		// ResourceGroupRef is the Azure Resource Group the NetworkInterface resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef"`
+
+		// Location of the NetworkInterface in Azure, which must be the location of the VirtualNetwork of its subnets
+		// +kubebuilder:validation:Required
+		Location string `json:"location"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the NetworkInterface
+		Properties *NetworkInterfaceSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// NetworkInterfaceStatus defines the observed state of NetworkInterface
+	NetworkInterfaceStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// NetworkInterface is the Schema for the networkinterfaces API
+	NetworkInterface struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   NetworkInterfaceSpec   `json:"spec,omitempty"`
+		Status NetworkInterfaceStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// NetworkInterfaceList contains a list of NetworkInterface
+	NetworkInterfaceList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []NetworkInterface `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&NetworkInterface{}, &NetworkInterfaceList{})
+}
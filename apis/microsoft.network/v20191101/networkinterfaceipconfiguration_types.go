@@ -26,6 +26,10 @@ type (
 	// NetworkInterfaceIPConfigurationSpec defines the desired state of NetworkInterfaceIPConfiguration
 	NetworkInterfaceIPConfigurationSpec struct {
 		Properties *NetworkInterfaceIPConfigurationSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// NetworkInterfaceIPConfigurationStatus defines the observed state of NetworkInterfaceIPConfiguration
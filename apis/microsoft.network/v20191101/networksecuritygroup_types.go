@@ -32,6 +32,10 @@ type (
 
 		// Properties of the Virtual Network
 		Properties *NetworkSecurityGroupSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// NetworkSecurityGroupStatus defines the observed state of NetworkSecurityGroup
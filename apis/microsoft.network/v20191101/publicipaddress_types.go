@@ -0,0 +1,90 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20191101
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// PublicIPAddressDNSSettings are the DNS settings of the public IP address
+	PublicIPAddressDNSSettings struct {
+		DomainNameLabel string `json:"domainNameLabel,omitempty"`
+		ReverseFQDN     string `json:"reverseFqdn,omitempty"`
+	}
+
+	// PublicIPAddressSpecProperties are the resource specific properties
+	PublicIPAddressSpecProperties struct {
+		DNSSettings          *PublicIPAddressDNSSettings `json:"dnsSettings,omitempty"`
+		IdleTimeoutInMinutes int                         `json:"idleTimeoutInMinutes,omitempty"`
+		// +kubebuilder:validation:Enum=IPv4;IPv6
+		PublicIPAddressVersion string `json:"publicIPAddressVersion,omitempty"`
+		// +kubebuilder:validation:Enum=Dynamic;Static
+		PublicIPAllocationMethod string `json:"publicIPAllocationMethod,omitempty"`
+	}
+
+	// PublicIPAddressSpec defines the desired state of PublicIPAddress
+	PublicIPAddressSpec struct {
+		// ResourceGroupRef is the Azure Resource Group the PublicIPAddress resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef"`
+
+		// Location of the PublicIPAddress in Azure
+		// +kubebuilder:validation:Required
+		Location string `json:"location"`
+
+		// SKU of the PublicIPAddress, which must match the SKU of any LoadBalancer it is used by
+		// +kubebuilder:validation:Enum=Basic;Standard
+		SKU string `json:"sku,omitempty"`
+
+		// Zones the PublicIPAddress is allocated within
+		// +optional
+		Zones []string `json:"zones,omitempty"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the PublicIPAddress
+		Properties *PublicIPAddressSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// PublicIPAddressStatus defines the observed state of PublicIPAddress
+	PublicIPAddressStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// PublicIPAddress is the Schema for the publicipaddresses API
+	PublicIPAddress struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   PublicIPAddressSpec   `json:"spec,omitempty"`
+		Status PublicIPAddressStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// PublicIPAddressList contains a list of PublicIPAddress
+	PublicIPAddressList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []PublicIPAddress `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&PublicIPAddress{}, &PublicIPAddressList{})
+}
@@ -35,6 +35,10 @@ type (
 
 		// Properties of the Virtual Network
 		Properties *RouteTableSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// RouteTableStatus defines the observed state of RouteTable
@@ -7,6 +7,8 @@ package v20191101
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
 
 type (
@@ -38,6 +40,10 @@ type (
 	// SecurityRuleSpec defines the desired state of SecurityRule
 	SecurityRuleSpec struct {
 		Properties *SecurityRuleSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// SecurityRuleStatus defines the observed state of SecurityRule
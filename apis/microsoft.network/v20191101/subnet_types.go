@@ -7,6 +7,8 @@ package v20191101
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
 
 type (
@@ -23,6 +25,10 @@ type (
 	SubnetSpec struct {
 		// Properties of the subnet
 		Properties SubnetProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// SubnetStatus defines the observed state of Subnet
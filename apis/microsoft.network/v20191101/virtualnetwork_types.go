@@ -51,6 +51,10 @@ type (
 		// +optional
 		SubnetRefs []azcorev1.KnownTypeReference `json:"subnetRefs,omitempty"`
 
+		// VirtualNetworkPeeringRefs are the peerings of the VNET with other virtual networks
+		// +optional
+		VirtualNetworkPeeringRefs []azcorev1.KnownTypeReference `json:"virtualNetworkPeeringRefs,omitempty"`
+
 		// EnableVMProtection indicates if VM protection is enabled for all the subnets in the virtual network
 		// +optional
 		EnableVMProtection bool `json:"enableVMProtection,omitempty"`
@@ -70,6 +74,10 @@ type (
 
 		// Properties of the Virtual Network
 		Properties *VirtualNetworkSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// VirtualNetworkStatus defines the observed state of VirtualNetwork
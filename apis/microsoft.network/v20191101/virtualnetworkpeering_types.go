@@ -0,0 +1,86 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20191101
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// VirtualNetworkPeeringSpecProperties are the resource specific properties
+	VirtualNetworkPeeringSpecProperties struct {
+		// AllowVirtualNetworkAccess allows VMs in the remote virtual network to access VMs in the local virtual
+		// network, which Azure enables unless it is set to false
+		// +optional
+		AllowVirtualNetworkAccess *bool `json:"allowVirtualNetworkAccess,omitempty"`
+
+		// AllowForwardedTraffic allows traffic forwarded by VMs in the remote virtual network into the local virtual
+		// network
+		// +optional
+		AllowForwardedTraffic bool `json:"allowForwardedTraffic,omitempty"`
+
+		// AllowGatewayTransit allows the remote virtual network to use the gateway of the local virtual network
+		// +optional
+		AllowGatewayTransit bool `json:"allowGatewayTransit,omitempty"`
+
+		// UseRemoteGateways uses the gateway of the remote virtual network, which must allow gateway transit
+		// +optional
+		UseRemoteGateways bool `json:"useRemoteGateways,omitempty"`
+
+		// RemoteVirtualNetworkRef is the VirtualNetwork to peer with, which may be in another resource group. Either
+		// remoteVirtualNetworkRef or remoteVirtualNetworkId is required.
+		// +optional
+		RemoteVirtualNetworkRef *azcorev1.KnownTypeReference `json:"remoteVirtualNetworkRef,omitempty"`
+
+		// RemoteVirtualNetworkID is the ARM ID of the virtual network to peer with, for a virtual network which is
+		// not managed within the cluster
+		// +optional
+		RemoteVirtualNetworkID string `json:"remoteVirtualNetworkId,omitempty"`
+	}
+
+	// VirtualNetworkPeeringSpec defines the desired state of VirtualNetworkPeering
+	VirtualNetworkPeeringSpec struct {
+		// Properties of the VirtualNetworkPeering
+		// +kubebuilder:validation:Required
+		Properties *VirtualNetworkPeeringSpecProperties `json:"properties"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// VirtualNetworkPeeringStatus defines the observed state of VirtualNetworkPeering
+	VirtualNetworkPeeringStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VirtualNetworkPeering is the Schema for the virtualnetworkpeerings API
+	VirtualNetworkPeering struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   VirtualNetworkPeeringSpec   `json:"spec,omitempty"`
+		Status VirtualNetworkPeeringStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// VirtualNetworkPeeringList contains a list of VirtualNetworkPeering
+	VirtualNetworkPeeringList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []VirtualNetworkPeering `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&VirtualNetworkPeering{}, &VirtualNetworkPeeringList{})
+}
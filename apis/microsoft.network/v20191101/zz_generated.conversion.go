@@ -304,6 +304,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterface)(nil), (*v1.NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_NetworkInterface_To_v1_NetworkInterface(a.(*NetworkInterface), b.(*v1.NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NetworkInterface)(nil), (*NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkInterface_To_v20191101_NetworkInterface(a.(*v1.NetworkInterface), b.(*NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceDNSSettings)(nil), (*v1.NetworkInterfaceDNSSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_NetworkInterfaceDNSSettings_To_v1_NetworkInterfaceDNSSettings(a.(*NetworkInterfaceDNSSettings), b.(*v1.NetworkInterfaceDNSSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NetworkInterfaceDNSSettings)(nil), (*NetworkInterfaceDNSSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkInterfaceDNSSettings_To_v20191101_NetworkInterfaceDNSSettings(a.(*v1.NetworkInterfaceDNSSettings), b.(*NetworkInterfaceDNSSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceIPConfiguration)(nil), (*v1.NetworkInterfaceIPConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v20191101_NetworkInterfaceIPConfiguration_To_v1_NetworkInterfaceIPConfiguration(a.(*NetworkInterfaceIPConfiguration), b.(*v1.NetworkInterfaceIPConfiguration), scope)
 	}); err != nil {
@@ -354,6 +374,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceList)(nil), (*v1.NetworkInterfaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_NetworkInterfaceList_To_v1_NetworkInterfaceList(a.(*NetworkInterfaceList), b.(*v1.NetworkInterfaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NetworkInterfaceList)(nil), (*NetworkInterfaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkInterfaceList_To_v20191101_NetworkInterfaceList(a.(*v1.NetworkInterfaceList), b.(*NetworkInterfaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceSpec)(nil), (*v1.NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_NetworkInterfaceSpec_To_v1_NetworkInterfaceSpec(a.(*NetworkInterfaceSpec), b.(*v1.NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NetworkInterfaceSpec)(nil), (*NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkInterfaceSpec_To_v20191101_NetworkInterfaceSpec(a.(*v1.NetworkInterfaceSpec), b.(*NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceSpecProperties)(nil), (*v1.NetworkInterfaceSpecProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_NetworkInterfaceSpecProperties_To_v1_NetworkInterfaceSpecProperties(a.(*NetworkInterfaceSpecProperties), b.(*v1.NetworkInterfaceSpecProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NetworkInterfaceSpecProperties)(nil), (*NetworkInterfaceSpecProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkInterfaceSpecProperties_To_v20191101_NetworkInterfaceSpecProperties(a.(*v1.NetworkInterfaceSpecProperties), b.(*NetworkInterfaceSpecProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceStatus)(nil), (*v1.NetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_NetworkInterfaceStatus_To_v1_NetworkInterfaceStatus(a.(*NetworkInterfaceStatus), b.(*v1.NetworkInterfaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NetworkInterfaceStatus)(nil), (*NetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkInterfaceStatus_To_v20191101_NetworkInterfaceStatus(a.(*v1.NetworkInterfaceStatus), b.(*NetworkInterfaceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkSecurityGroup)(nil), (*v1.NetworkSecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v20191101_NetworkSecurityGroup_To_v1_NetworkSecurityGroup(a.(*NetworkSecurityGroup), b.(*v1.NetworkSecurityGroup), scope)
 	}); err != nil {
@@ -454,6 +514,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPAddress)(nil), (*v1.PublicIPAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_PublicIPAddress_To_v1_PublicIPAddress(a.(*PublicIPAddress), b.(*v1.PublicIPAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PublicIPAddress)(nil), (*PublicIPAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PublicIPAddress_To_v20191101_PublicIPAddress(a.(*v1.PublicIPAddress), b.(*PublicIPAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPAddressDNSSettings)(nil), (*v1.PublicIPAddressDNSSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_PublicIPAddressDNSSettings_To_v1_PublicIPAddressDNSSettings(a.(*PublicIPAddressDNSSettings), b.(*v1.PublicIPAddressDNSSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PublicIPAddressDNSSettings)(nil), (*PublicIPAddressDNSSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PublicIPAddressDNSSettings_To_v20191101_PublicIPAddressDNSSettings(a.(*v1.PublicIPAddressDNSSettings), b.(*PublicIPAddressDNSSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPAddressList)(nil), (*v1.PublicIPAddressList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_PublicIPAddressList_To_v1_PublicIPAddressList(a.(*PublicIPAddressList), b.(*v1.PublicIPAddressList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PublicIPAddressList)(nil), (*PublicIPAddressList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PublicIPAddressList_To_v20191101_PublicIPAddressList(a.(*v1.PublicIPAddressList), b.(*PublicIPAddressList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPAddressSpec)(nil), (*v1.PublicIPAddressSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_PublicIPAddressSpec_To_v1_PublicIPAddressSpec(a.(*PublicIPAddressSpec), b.(*v1.PublicIPAddressSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PublicIPAddressSpec)(nil), (*PublicIPAddressSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PublicIPAddressSpec_To_v20191101_PublicIPAddressSpec(a.(*v1.PublicIPAddressSpec), b.(*PublicIPAddressSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPAddressSpecProperties)(nil), (*v1.PublicIPAddressSpecProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_PublicIPAddressSpecProperties_To_v1_PublicIPAddressSpecProperties(a.(*PublicIPAddressSpecProperties), b.(*v1.PublicIPAddressSpecProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PublicIPAddressSpecProperties)(nil), (*PublicIPAddressSpecProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PublicIPAddressSpecProperties_To_v20191101_PublicIPAddressSpecProperties(a.(*v1.PublicIPAddressSpecProperties), b.(*PublicIPAddressSpecProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPAddressStatus)(nil), (*v1.PublicIPAddressStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_PublicIPAddressStatus_To_v1_PublicIPAddressStatus(a.(*PublicIPAddressStatus), b.(*v1.PublicIPAddressStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PublicIPAddressStatus)(nil), (*PublicIPAddressStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PublicIPAddressStatus_To_v20191101_PublicIPAddressStatus(a.(*v1.PublicIPAddressStatus), b.(*PublicIPAddressStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Route)(nil), (*v1.Route)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v20191101_Route_To_v1_Route(a.(*Route), b.(*v1.Route), scope)
 	}); err != nil {
@@ -674,6 +794,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetworkPeering)(nil), (*v1.VirtualNetworkPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_VirtualNetworkPeering_To_v1_VirtualNetworkPeering(a.(*VirtualNetworkPeering), b.(*v1.VirtualNetworkPeering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VirtualNetworkPeering)(nil), (*VirtualNetworkPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VirtualNetworkPeering_To_v20191101_VirtualNetworkPeering(a.(*v1.VirtualNetworkPeering), b.(*VirtualNetworkPeering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetworkPeeringList)(nil), (*v1.VirtualNetworkPeeringList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_VirtualNetworkPeeringList_To_v1_VirtualNetworkPeeringList(a.(*VirtualNetworkPeeringList), b.(*v1.VirtualNetworkPeeringList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VirtualNetworkPeeringList)(nil), (*VirtualNetworkPeeringList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VirtualNetworkPeeringList_To_v20191101_VirtualNetworkPeeringList(a.(*v1.VirtualNetworkPeeringList), b.(*VirtualNetworkPeeringList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetworkPeeringSpec)(nil), (*v1.VirtualNetworkPeeringSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_VirtualNetworkPeeringSpec_To_v1_VirtualNetworkPeeringSpec(a.(*VirtualNetworkPeeringSpec), b.(*v1.VirtualNetworkPeeringSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VirtualNetworkPeeringSpec)(nil), (*VirtualNetworkPeeringSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VirtualNetworkPeeringSpec_To_v20191101_VirtualNetworkPeeringSpec(a.(*v1.VirtualNetworkPeeringSpec), b.(*VirtualNetworkPeeringSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetworkPeeringSpecProperties)(nil), (*v1.VirtualNetworkPeeringSpecProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_VirtualNetworkPeeringSpecProperties_To_v1_VirtualNetworkPeeringSpecProperties(a.(*VirtualNetworkPeeringSpecProperties), b.(*v1.VirtualNetworkPeeringSpecProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VirtualNetworkPeeringSpecProperties)(nil), (*VirtualNetworkPeeringSpecProperties)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VirtualNetworkPeeringSpecProperties_To_v20191101_VirtualNetworkPeeringSpecProperties(a.(*v1.VirtualNetworkPeeringSpecProperties), b.(*VirtualNetworkPeeringSpecProperties), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualNetworkPeeringStatus)(nil), (*v1.VirtualNetworkPeeringStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v20191101_VirtualNetworkPeeringStatus_To_v1_VirtualNetworkPeeringStatus(a.(*VirtualNetworkPeeringStatus), b.(*v1.VirtualNetworkPeeringStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VirtualNetworkPeeringStatus)(nil), (*VirtualNetworkPeeringStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VirtualNetworkPeeringStatus_To_v20191101_VirtualNetworkPeeringStatus(a.(*v1.VirtualNetworkPeeringStatus), b.(*VirtualNetworkPeeringStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VirtualNetworkSpec)(nil), (*v1.VirtualNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v20191101_VirtualNetworkSpec_To_v1_VirtualNetworkSpec(a.(*VirtualNetworkSpec), b.(*v1.VirtualNetworkSpec), scope)
 	}); err != nil {
@@ -825,6 +995,7 @@ func Convert_v1_BackendAddressPoolList_To_v20191101_BackendAddressPoolList(in *v
 
 func autoConvert_v20191101_BackendAddressPoolSpec_To_v1_BackendAddressPoolSpec(in *BackendAddressPoolSpec, out *v1.BackendAddressPoolSpec, s conversion.Scope) error {
 	out.Properties = (*v1.BackendAddressPoolSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -836,6 +1007,7 @@ func Convert_v20191101_BackendAddressPoolSpec_To_v1_BackendAddressPoolSpec(in *B
 func autoConvert_v1_BackendAddressPoolSpec_To_v20191101_BackendAddressPoolSpec(in *v1.BackendAddressPoolSpec, out *BackendAddressPoolSpec, s conversion.Scope) error {
 	// INFO: in.APIVersion opted out of conversion generation
 	out.Properties = (*BackendAddressPoolSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -883,6 +1055,8 @@ func autoConvert_v1_BackendAddressPoolStatus_To_v20191101_BackendAddressPoolStat
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -987,6 +1161,7 @@ func Convert_v1_FrontendIPConfigurationList_To_v20191101_FrontendIPConfiguration
 
 func autoConvert_v20191101_FrontendIPConfigurationSpec_To_v1_FrontendIPConfigurationSpec(in *FrontendIPConfigurationSpec, out *v1.FrontendIPConfigurationSpec, s conversion.Scope) error {
 	out.Properties = (*v1.FrontendIPConfigurationSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -998,6 +1173,7 @@ func Convert_v20191101_FrontendIPConfigurationSpec_To_v1_FrontendIPConfiguration
 func autoConvert_v1_FrontendIPConfigurationSpec_To_v20191101_FrontendIPConfigurationSpec(in *v1.FrontendIPConfigurationSpec, out *FrontendIPConfigurationSpec, s conversion.Scope) error {
 	// INFO: in.APIVersion opted out of conversion generation
 	out.Properties = (*FrontendIPConfigurationSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1051,6 +1227,9 @@ func autoConvert_v1_FrontendIPConfigurationStatus_To_v20191101_FrontendIPConfigu
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.PrivateIPAddress opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -1135,6 +1314,7 @@ func Convert_v1_InboundNatRuleList_To_v20191101_InboundNatRuleList(in *v1.Inboun
 
 func autoConvert_v20191101_InboundNatRuleSpec_To_v1_InboundNatRuleSpec(in *InboundNatRuleSpec, out *v1.InboundNatRuleSpec, s conversion.Scope) error {
 	out.Properties = (*v1.InboundNatRuleSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1146,6 +1326,7 @@ func Convert_v20191101_InboundNatRuleSpec_To_v1_InboundNatRuleSpec(in *InboundNa
 func autoConvert_v1_InboundNatRuleSpec_To_v20191101_InboundNatRuleSpec(in *v1.InboundNatRuleSpec, out *InboundNatRuleSpec, s conversion.Scope) error {
 	// INFO: in.APIVersion opted out of conversion generation
 	out.Properties = (*InboundNatRuleSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1199,6 +1380,8 @@ func autoConvert_v1_InboundNatRuleStatus_To_v20191101_InboundNatRuleStatus(in *v
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -1323,6 +1506,7 @@ func autoConvert_v20191101_LoadBalancerSpec_To_v1_LoadBalancerSpec(in *LoadBalan
 	out.SKU = in.SKU
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.Properties = (*v1.LoadBalancerSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1338,6 +1522,7 @@ func autoConvert_v1_LoadBalancerSpec_To_v20191101_LoadBalancerSpec(in *v1.LoadBa
 	out.SKU = in.SKU
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.Properties = (*LoadBalancerSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1387,6 +1572,9 @@ func autoConvert_v1_LoadBalancerStatus_To_v20191101_LoadBalancerStatus(in *v1.Lo
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.ResourceGUID opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -1471,6 +1659,7 @@ func Convert_v1_LoadBalancingRuleList_To_v20191101_LoadBalancingRuleList(in *v1.
 
 func autoConvert_v20191101_LoadBalancingRuleSpec_To_v1_LoadBalancingRuleSpec(in *LoadBalancingRuleSpec, out *v1.LoadBalancingRuleSpec, s conversion.Scope) error {
 	out.Properties = (*v1.LoadBalancerRuleSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1482,6 +1671,7 @@ func Convert_v20191101_LoadBalancingRuleSpec_To_v1_LoadBalancingRuleSpec(in *Loa
 func autoConvert_v1_LoadBalancingRuleSpec_To_v20191101_LoadBalancingRuleSpec(in *v1.LoadBalancingRuleSpec, out *LoadBalancingRuleSpec, s conversion.Scope) error {
 	// INFO: in.APIVersion opted out of conversion generation
 	out.Properties = (*LoadBalancerRuleSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1505,6 +1695,8 @@ func autoConvert_v1_LoadBalancingRuleStatus_To_v20191101_LoadBalancingRuleStatus
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -1513,6 +1705,60 @@ func Convert_v1_LoadBalancingRuleStatus_To_v20191101_LoadBalancingRuleStatus(in
 	return autoConvert_v1_LoadBalancingRuleStatus_To_v20191101_LoadBalancingRuleStatus(in, out, s)
 }
 
+func autoConvert_v20191101_NetworkInterface_To_v1_NetworkInterface(in *NetworkInterface, out *v1.NetworkInterface, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v20191101_NetworkInterfaceSpec_To_v1_NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v20191101_NetworkInterfaceStatus_To_v1_NetworkInterfaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v20191101_NetworkInterface_To_v1_NetworkInterface is an autogenerated conversion function.
+func Convert_v20191101_NetworkInterface_To_v1_NetworkInterface(in *NetworkInterface, out *v1.NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v20191101_NetworkInterface_To_v1_NetworkInterface(in, out, s)
+}
+
+func autoConvert_v1_NetworkInterface_To_v20191101_NetworkInterface(in *v1.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_NetworkInterfaceSpec_To_v20191101_NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_NetworkInterfaceStatus_To_v20191101_NetworkInterfaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NetworkInterface_To_v20191101_NetworkInterface is an autogenerated conversion function.
+func Convert_v1_NetworkInterface_To_v20191101_NetworkInterface(in *v1.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1_NetworkInterface_To_v20191101_NetworkInterface(in, out, s)
+}
+
+func autoConvert_v20191101_NetworkInterfaceDNSSettings_To_v1_NetworkInterfaceDNSSettings(in *NetworkInterfaceDNSSettings, out *v1.NetworkInterfaceDNSSettings, s conversion.Scope) error {
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.InternalDNSNameLabel = in.InternalDNSNameLabel
+	return nil
+}
+
+// Convert_v20191101_NetworkInterfaceDNSSettings_To_v1_NetworkInterfaceDNSSettings is an autogenerated conversion function.
+func Convert_v20191101_NetworkInterfaceDNSSettings_To_v1_NetworkInterfaceDNSSettings(in *NetworkInterfaceDNSSettings, out *v1.NetworkInterfaceDNSSettings, s conversion.Scope) error {
+	return autoConvert_v20191101_NetworkInterfaceDNSSettings_To_v1_NetworkInterfaceDNSSettings(in, out, s)
+}
+
+func autoConvert_v1_NetworkInterfaceDNSSettings_To_v20191101_NetworkInterfaceDNSSettings(in *v1.NetworkInterfaceDNSSettings, out *NetworkInterfaceDNSSettings, s conversion.Scope) error {
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.InternalDNSNameLabel = in.InternalDNSNameLabel
+	return nil
+}
+
+// Convert_v1_NetworkInterfaceDNSSettings_To_v20191101_NetworkInterfaceDNSSettings is an autogenerated conversion function.
+func Convert_v1_NetworkInterfaceDNSSettings_To_v20191101_NetworkInterfaceDNSSettings(in *v1.NetworkInterfaceDNSSettings, out *NetworkInterfaceDNSSettings, s conversion.Scope) error {
+	return autoConvert_v1_NetworkInterfaceDNSSettings_To_v20191101_NetworkInterfaceDNSSettings(in, out, s)
+}
+
 func autoConvert_v20191101_NetworkInterfaceIPConfiguration_To_v1_NetworkInterfaceIPConfiguration(in *NetworkInterfaceIPConfiguration, out *v1.NetworkInterfaceIPConfiguration, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v20191101_NetworkInterfaceIPConfigurationSpec_To_v1_NetworkInterfaceIPConfigurationSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1589,6 +1835,7 @@ func Convert_v1_NetworkInterfaceIPConfigurationList_To_v20191101_NetworkInterfac
 
 func autoConvert_v20191101_NetworkInterfaceIPConfigurationSpec_To_v1_NetworkInterfaceIPConfigurationSpec(in *NetworkInterfaceIPConfigurationSpec, out *v1.NetworkInterfaceIPConfigurationSpec, s conversion.Scope) error {
 	out.Properties = (*v1.NetworkInterfaceIPConfigurationSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1600,6 +1847,7 @@ func Convert_v20191101_NetworkInterfaceIPConfigurationSpec_To_v1_NetworkInterfac
 func autoConvert_v1_NetworkInterfaceIPConfigurationSpec_To_v20191101_NetworkInterfaceIPConfigurationSpec(in *v1.NetworkInterfaceIPConfigurationSpec, out *NetworkInterfaceIPConfigurationSpec, s conversion.Scope) error {
 	// INFO: in.APIVersion opted out of conversion generation
 	out.Properties = (*NetworkInterfaceIPConfigurationSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1653,6 +1901,9 @@ func autoConvert_v1_NetworkInterfaceIPConfigurationStatus_To_v20191101_NetworkIn
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.PrivateIPAddress opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -1661,6 +1912,132 @@ func Convert_v1_NetworkInterfaceIPConfigurationStatus_To_v20191101_NetworkInterf
 	return autoConvert_v1_NetworkInterfaceIPConfigurationStatus_To_v20191101_NetworkInterfaceIPConfigurationStatus(in, out, s)
 }
 
+func autoConvert_v20191101_NetworkInterfaceList_To_v1_NetworkInterfaceList(in *NetworkInterfaceList, out *v1.NetworkInterfaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.NetworkInterface, len(*in))
+		for i := range *in {
+			if err := Convert_v20191101_NetworkInterface_To_v1_NetworkInterface(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v20191101_NetworkInterfaceList_To_v1_NetworkInterfaceList is an autogenerated conversion function.
+func Convert_v20191101_NetworkInterfaceList_To_v1_NetworkInterfaceList(in *NetworkInterfaceList, out *v1.NetworkInterfaceList, s conversion.Scope) error {
+	return autoConvert_v20191101_NetworkInterfaceList_To_v1_NetworkInterfaceList(in, out, s)
+}
+
+func autoConvert_v1_NetworkInterfaceList_To_v20191101_NetworkInterfaceList(in *v1.NetworkInterfaceList, out *NetworkInterfaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			if err := Convert_v1_NetworkInterface_To_v20191101_NetworkInterface(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_NetworkInterfaceList_To_v20191101_NetworkInterfaceList is an autogenerated conversion function.
+func Convert_v1_NetworkInterfaceList_To_v20191101_NetworkInterfaceList(in *v1.NetworkInterfaceList, out *NetworkInterfaceList, s conversion.Scope) error {
+	return autoConvert_v1_NetworkInterfaceList_To_v20191101_NetworkInterfaceList(in, out, s)
+}
+
+func autoConvert_v20191101_NetworkInterfaceSpec_To_v1_NetworkInterfaceSpec(in *NetworkInterfaceSpec, out *v1.NetworkInterfaceSpec, s conversion.Scope) error {
+	out.ResourceGroupRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.ResourceGroupRef))
+	out.Location = in.Location
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Properties = (*v1.NetworkInterfaceSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
+	return nil
+}
+
+// Convert_v20191101_NetworkInterfaceSpec_To_v1_NetworkInterfaceSpec is an autogenerated conversion function.
+func Convert_v20191101_NetworkInterfaceSpec_To_v1_NetworkInterfaceSpec(in *NetworkInterfaceSpec, out *v1.NetworkInterfaceSpec, s conversion.Scope) error {
+	return autoConvert_v20191101_NetworkInterfaceSpec_To_v1_NetworkInterfaceSpec(in, out, s)
+}
+
+func autoConvert_v1_NetworkInterfaceSpec_To_v20191101_NetworkInterfaceSpec(in *v1.NetworkInterfaceSpec, out *NetworkInterfaceSpec, s conversion.Scope) error {
+	// INFO: in.APIVersion opted out of conversion generation
+	out.ResourceGroupRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.ResourceGroupRef))
+	out.Location = in.Location
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Properties = (*NetworkInterfaceSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
+	return nil
+}
+
+// Convert_v1_NetworkInterfaceSpec_To_v20191101_NetworkInterfaceSpec is an autogenerated conversion function.
+func Convert_v1_NetworkInterfaceSpec_To_v20191101_NetworkInterfaceSpec(in *v1.NetworkInterfaceSpec, out *NetworkInterfaceSpec, s conversion.Scope) error {
+	return autoConvert_v1_NetworkInterfaceSpec_To_v20191101_NetworkInterfaceSpec(in, out, s)
+}
+
+func autoConvert_v20191101_NetworkInterfaceSpecProperties_To_v1_NetworkInterfaceSpecProperties(in *NetworkInterfaceSpecProperties, out *v1.NetworkInterfaceSpecProperties, s conversion.Scope) error {
+	out.DNSSettings = (*v1.NetworkInterfaceDNSSettings)(unsafe.Pointer(in.DNSSettings))
+	out.EnableAcceleratedNetworking = in.EnableAcceleratedNetworking
+	out.EnableIPForwarding = in.EnableIPForwarding
+	out.IPConfigurationRefs = *(*[]corev1.KnownTypeReference)(unsafe.Pointer(&in.IPConfigurationRefs))
+	out.NetworkSecurityGroupRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.NetworkSecurityGroupRef))
+	return nil
+}
+
+// Convert_v20191101_NetworkInterfaceSpecProperties_To_v1_NetworkInterfaceSpecProperties is an autogenerated conversion function.
+func Convert_v20191101_NetworkInterfaceSpecProperties_To_v1_NetworkInterfaceSpecProperties(in *NetworkInterfaceSpecProperties, out *v1.NetworkInterfaceSpecProperties, s conversion.Scope) error {
+	return autoConvert_v20191101_NetworkInterfaceSpecProperties_To_v1_NetworkInterfaceSpecProperties(in, out, s)
+}
+
+func autoConvert_v1_NetworkInterfaceSpecProperties_To_v20191101_NetworkInterfaceSpecProperties(in *v1.NetworkInterfaceSpecProperties, out *NetworkInterfaceSpecProperties, s conversion.Scope) error {
+	out.DNSSettings = (*NetworkInterfaceDNSSettings)(unsafe.Pointer(in.DNSSettings))
+	out.EnableAcceleratedNetworking = in.EnableAcceleratedNetworking
+	out.EnableIPForwarding = in.EnableIPForwarding
+	out.IPConfigurationRefs = *(*[]corev1.KnownTypeReference)(unsafe.Pointer(&in.IPConfigurationRefs))
+	out.NetworkSecurityGroupRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.NetworkSecurityGroupRef))
+	return nil
+}
+
+// Convert_v1_NetworkInterfaceSpecProperties_To_v20191101_NetworkInterfaceSpecProperties is an autogenerated conversion function.
+func Convert_v1_NetworkInterfaceSpecProperties_To_v20191101_NetworkInterfaceSpecProperties(in *v1.NetworkInterfaceSpecProperties, out *NetworkInterfaceSpecProperties, s conversion.Scope) error {
+	return autoConvert_v1_NetworkInterfaceSpecProperties_To_v20191101_NetworkInterfaceSpecProperties(in, out, s)
+}
+
+func autoConvert_v20191101_NetworkInterfaceStatus_To_v1_NetworkInterfaceStatus(in *NetworkInterfaceStatus, out *v1.NetworkInterfaceStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ProvisioningState = in.ProvisioningState
+	return nil
+}
+
+// Convert_v20191101_NetworkInterfaceStatus_To_v1_NetworkInterfaceStatus is an autogenerated conversion function.
+func Convert_v20191101_NetworkInterfaceStatus_To_v1_NetworkInterfaceStatus(in *NetworkInterfaceStatus, out *v1.NetworkInterfaceStatus, s conversion.Scope) error {
+	return autoConvert_v20191101_NetworkInterfaceStatus_To_v1_NetworkInterfaceStatus(in, out, s)
+}
+
+func autoConvert_v1_NetworkInterfaceStatus_To_v20191101_NetworkInterfaceStatus(in *v1.NetworkInterfaceStatus, out *NetworkInterfaceStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	// INFO: in.DeploymentID opted out of conversion generation
+	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.MACAddress opted out of conversion generation
+	// INFO: in.VirtualMachine opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
+	return nil
+}
+
+// Convert_v1_NetworkInterfaceStatus_To_v20191101_NetworkInterfaceStatus is an autogenerated conversion function.
+func Convert_v1_NetworkInterfaceStatus_To_v20191101_NetworkInterfaceStatus(in *v1.NetworkInterfaceStatus, out *NetworkInterfaceStatus, s conversion.Scope) error {
+	return autoConvert_v1_NetworkInterfaceStatus_To_v20191101_NetworkInterfaceStatus(in, out, s)
+}
+
 func autoConvert_v20191101_NetworkSecurityGroup_To_v1_NetworkSecurityGroup(in *NetworkSecurityGroup, out *v1.NetworkSecurityGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v20191101_NetworkSecurityGroupSpec_To_v1_NetworkSecurityGroupSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1740,6 +2117,7 @@ func autoConvert_v20191101_NetworkSecurityGroupSpec_To_v1_NetworkSecurityGroupSp
 	out.Location = in.Location
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.Properties = (*v1.NetworkSecurityGroupSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1754,6 +2132,7 @@ func autoConvert_v1_NetworkSecurityGroupSpec_To_v20191101_NetworkSecurityGroupSp
 	out.Location = in.Location
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.Properties = (*NetworkSecurityGroupSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1797,6 +2176,9 @@ func autoConvert_v1_NetworkSecurityGroupStatus_To_v20191101_NetworkSecurityGroup
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.ResourceGUID opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -1881,6 +2263,7 @@ func Convert_v1_OutboundRuleList_To_v20191101_OutboundRuleList(in *v1.OutboundRu
 
 func autoConvert_v20191101_OutboundRuleSpec_To_v1_OutboundRuleSpec(in *OutboundRuleSpec, out *v1.OutboundRuleSpec, s conversion.Scope) error {
 	out.Properties = (*v1.OutboundRuleSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1892,6 +2275,7 @@ func Convert_v20191101_OutboundRuleSpec_To_v1_OutboundRuleSpec(in *OutboundRuleS
 func autoConvert_v1_OutboundRuleSpec_To_v20191101_OutboundRuleSpec(in *v1.OutboundRuleSpec, out *OutboundRuleSpec, s conversion.Scope) error {
 	// INFO: in.APIVersion opted out of conversion generation
 	out.Properties = (*OutboundRuleSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -1941,6 +2325,8 @@ func autoConvert_v1_OutboundRuleStatus_To_v20191101_OutboundRuleStatus(in *v1.Ou
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -1949,6 +2335,188 @@ func Convert_v1_OutboundRuleStatus_To_v20191101_OutboundRuleStatus(in *v1.Outbou
 	return autoConvert_v1_OutboundRuleStatus_To_v20191101_OutboundRuleStatus(in, out, s)
 }
 
+func autoConvert_v20191101_PublicIPAddress_To_v1_PublicIPAddress(in *PublicIPAddress, out *v1.PublicIPAddress, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v20191101_PublicIPAddressSpec_To_v1_PublicIPAddressSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v20191101_PublicIPAddressStatus_To_v1_PublicIPAddressStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v20191101_PublicIPAddress_To_v1_PublicIPAddress is an autogenerated conversion function.
+func Convert_v20191101_PublicIPAddress_To_v1_PublicIPAddress(in *PublicIPAddress, out *v1.PublicIPAddress, s conversion.Scope) error {
+	return autoConvert_v20191101_PublicIPAddress_To_v1_PublicIPAddress(in, out, s)
+}
+
+func autoConvert_v1_PublicIPAddress_To_v20191101_PublicIPAddress(in *v1.PublicIPAddress, out *PublicIPAddress, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_PublicIPAddressSpec_To_v20191101_PublicIPAddressSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_PublicIPAddressStatus_To_v20191101_PublicIPAddressStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_PublicIPAddress_To_v20191101_PublicIPAddress is an autogenerated conversion function.
+func Convert_v1_PublicIPAddress_To_v20191101_PublicIPAddress(in *v1.PublicIPAddress, out *PublicIPAddress, s conversion.Scope) error {
+	return autoConvert_v1_PublicIPAddress_To_v20191101_PublicIPAddress(in, out, s)
+}
+
+func autoConvert_v20191101_PublicIPAddressDNSSettings_To_v1_PublicIPAddressDNSSettings(in *PublicIPAddressDNSSettings, out *v1.PublicIPAddressDNSSettings, s conversion.Scope) error {
+	out.DomainNameLabel = in.DomainNameLabel
+	out.ReverseFQDN = in.ReverseFQDN
+	return nil
+}
+
+// Convert_v20191101_PublicIPAddressDNSSettings_To_v1_PublicIPAddressDNSSettings is an autogenerated conversion function.
+func Convert_v20191101_PublicIPAddressDNSSettings_To_v1_PublicIPAddressDNSSettings(in *PublicIPAddressDNSSettings, out *v1.PublicIPAddressDNSSettings, s conversion.Scope) error {
+	return autoConvert_v20191101_PublicIPAddressDNSSettings_To_v1_PublicIPAddressDNSSettings(in, out, s)
+}
+
+func autoConvert_v1_PublicIPAddressDNSSettings_To_v20191101_PublicIPAddressDNSSettings(in *v1.PublicIPAddressDNSSettings, out *PublicIPAddressDNSSettings, s conversion.Scope) error {
+	out.DomainNameLabel = in.DomainNameLabel
+	out.ReverseFQDN = in.ReverseFQDN
+	return nil
+}
+
+// Convert_v1_PublicIPAddressDNSSettings_To_v20191101_PublicIPAddressDNSSettings is an autogenerated conversion function.
+func Convert_v1_PublicIPAddressDNSSettings_To_v20191101_PublicIPAddressDNSSettings(in *v1.PublicIPAddressDNSSettings, out *PublicIPAddressDNSSettings, s conversion.Scope) error {
+	return autoConvert_v1_PublicIPAddressDNSSettings_To_v20191101_PublicIPAddressDNSSettings(in, out, s)
+}
+
+func autoConvert_v20191101_PublicIPAddressList_To_v1_PublicIPAddressList(in *PublicIPAddressList, out *v1.PublicIPAddressList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.PublicIPAddress, len(*in))
+		for i := range *in {
+			if err := Convert_v20191101_PublicIPAddress_To_v1_PublicIPAddress(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v20191101_PublicIPAddressList_To_v1_PublicIPAddressList is an autogenerated conversion function.
+func Convert_v20191101_PublicIPAddressList_To_v1_PublicIPAddressList(in *PublicIPAddressList, out *v1.PublicIPAddressList, s conversion.Scope) error {
+	return autoConvert_v20191101_PublicIPAddressList_To_v1_PublicIPAddressList(in, out, s)
+}
+
+func autoConvert_v1_PublicIPAddressList_To_v20191101_PublicIPAddressList(in *v1.PublicIPAddressList, out *PublicIPAddressList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublicIPAddress, len(*in))
+		for i := range *in {
+			if err := Convert_v1_PublicIPAddress_To_v20191101_PublicIPAddress(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_PublicIPAddressList_To_v20191101_PublicIPAddressList is an autogenerated conversion function.
+func Convert_v1_PublicIPAddressList_To_v20191101_PublicIPAddressList(in *v1.PublicIPAddressList, out *PublicIPAddressList, s conversion.Scope) error {
+	return autoConvert_v1_PublicIPAddressList_To_v20191101_PublicIPAddressList(in, out, s)
+}
+
+func autoConvert_v20191101_PublicIPAddressSpec_To_v1_PublicIPAddressSpec(in *PublicIPAddressSpec, out *v1.PublicIPAddressSpec, s conversion.Scope) error {
+	out.ResourceGroupRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.ResourceGroupRef))
+	out.Location = in.Location
+	out.SKU = in.SKU
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Properties = (*v1.PublicIPAddressSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
+	return nil
+}
+
+// Convert_v20191101_PublicIPAddressSpec_To_v1_PublicIPAddressSpec is an autogenerated conversion function.
+func Convert_v20191101_PublicIPAddressSpec_To_v1_PublicIPAddressSpec(in *PublicIPAddressSpec, out *v1.PublicIPAddressSpec, s conversion.Scope) error {
+	return autoConvert_v20191101_PublicIPAddressSpec_To_v1_PublicIPAddressSpec(in, out, s)
+}
+
+func autoConvert_v1_PublicIPAddressSpec_To_v20191101_PublicIPAddressSpec(in *v1.PublicIPAddressSpec, out *PublicIPAddressSpec, s conversion.Scope) error {
+	// INFO: in.APIVersion opted out of conversion generation
+	out.ResourceGroupRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.ResourceGroupRef))
+	out.Location = in.Location
+	out.SKU = in.SKU
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Properties = (*PublicIPAddressSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
+	return nil
+}
+
+// Convert_v1_PublicIPAddressSpec_To_v20191101_PublicIPAddressSpec is an autogenerated conversion function.
+func Convert_v1_PublicIPAddressSpec_To_v20191101_PublicIPAddressSpec(in *v1.PublicIPAddressSpec, out *PublicIPAddressSpec, s conversion.Scope) error {
+	return autoConvert_v1_PublicIPAddressSpec_To_v20191101_PublicIPAddressSpec(in, out, s)
+}
+
+func autoConvert_v20191101_PublicIPAddressSpecProperties_To_v1_PublicIPAddressSpecProperties(in *PublicIPAddressSpecProperties, out *v1.PublicIPAddressSpecProperties, s conversion.Scope) error {
+	out.DNSSettings = (*v1.PublicIPAddressDNSSettings)(unsafe.Pointer(in.DNSSettings))
+	out.IdleTimeoutInMinutes = in.IdleTimeoutInMinutes
+	out.PublicIPAddressVersion = in.PublicIPAddressVersion
+	out.PublicIPAllocationMethod = in.PublicIPAllocationMethod
+	return nil
+}
+
+// Convert_v20191101_PublicIPAddressSpecProperties_To_v1_PublicIPAddressSpecProperties is an autogenerated conversion function.
+func Convert_v20191101_PublicIPAddressSpecProperties_To_v1_PublicIPAddressSpecProperties(in *PublicIPAddressSpecProperties, out *v1.PublicIPAddressSpecProperties, s conversion.Scope) error {
+	return autoConvert_v20191101_PublicIPAddressSpecProperties_To_v1_PublicIPAddressSpecProperties(in, out, s)
+}
+
+func autoConvert_v1_PublicIPAddressSpecProperties_To_v20191101_PublicIPAddressSpecProperties(in *v1.PublicIPAddressSpecProperties, out *PublicIPAddressSpecProperties, s conversion.Scope) error {
+	out.DNSSettings = (*PublicIPAddressDNSSettings)(unsafe.Pointer(in.DNSSettings))
+	out.IdleTimeoutInMinutes = in.IdleTimeoutInMinutes
+	out.PublicIPAddressVersion = in.PublicIPAddressVersion
+	out.PublicIPAllocationMethod = in.PublicIPAllocationMethod
+	return nil
+}
+
+// Convert_v1_PublicIPAddressSpecProperties_To_v20191101_PublicIPAddressSpecProperties is an autogenerated conversion function.
+func Convert_v1_PublicIPAddressSpecProperties_To_v20191101_PublicIPAddressSpecProperties(in *v1.PublicIPAddressSpecProperties, out *PublicIPAddressSpecProperties, s conversion.Scope) error {
+	return autoConvert_v1_PublicIPAddressSpecProperties_To_v20191101_PublicIPAddressSpecProperties(in, out, s)
+}
+
+func autoConvert_v20191101_PublicIPAddressStatus_To_v1_PublicIPAddressStatus(in *PublicIPAddressStatus, out *v1.PublicIPAddressStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ProvisioningState = in.ProvisioningState
+	return nil
+}
+
+// Convert_v20191101_PublicIPAddressStatus_To_v1_PublicIPAddressStatus is an autogenerated conversion function.
+func Convert_v20191101_PublicIPAddressStatus_To_v1_PublicIPAddressStatus(in *PublicIPAddressStatus, out *v1.PublicIPAddressStatus, s conversion.Scope) error {
+	return autoConvert_v20191101_PublicIPAddressStatus_To_v1_PublicIPAddressStatus(in, out, s)
+}
+
+func autoConvert_v1_PublicIPAddressStatus_To_v20191101_PublicIPAddressStatus(in *v1.PublicIPAddressStatus, out *PublicIPAddressStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	// INFO: in.DeploymentID opted out of conversion generation
+	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.IPAddress opted out of conversion generation
+	// INFO: in.FQDN opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
+	return nil
+}
+
+// Convert_v1_PublicIPAddressStatus_To_v20191101_PublicIPAddressStatus is an autogenerated conversion function.
+func Convert_v1_PublicIPAddressStatus_To_v20191101_PublicIPAddressStatus(in *v1.PublicIPAddressStatus, out *PublicIPAddressStatus, s conversion.Scope) error {
+	return autoConvert_v1_PublicIPAddressStatus_To_v20191101_PublicIPAddressStatus(in, out, s)
+}
+
 func autoConvert_v20191101_Route_To_v1_Route(in *Route, out *v1.Route, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v20191101_RouteSpec_To_v1_RouteSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2025,6 +2593,7 @@ func Convert_v1_RouteList_To_v20191101_RouteList(in *v1.RouteList, out *RouteLis
 
 func autoConvert_v20191101_RouteSpec_To_v1_RouteSpec(in *RouteSpec, out *v1.RouteSpec, s conversion.Scope) error {
 	out.Properties = (*v1.RouteSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2036,6 +2605,7 @@ func Convert_v20191101_RouteSpec_To_v1_RouteSpec(in *RouteSpec, out *v1.RouteSpe
 func autoConvert_v1_RouteSpec_To_v20191101_RouteSpec(in *v1.RouteSpec, out *RouteSpec, s conversion.Scope) error {
 	// INFO: in.APIVersion opted out of conversion generation
 	out.Properties = (*RouteSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2083,6 +2653,8 @@ func autoConvert_v1_RouteStatus_To_v20191101_RouteStatus(in *v1.RouteStatus, out
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -2170,6 +2742,7 @@ func autoConvert_v20191101_RouteTableSpec_To_v1_RouteTableSpec(in *RouteTableSpe
 	out.Location = in.Location
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.Properties = (*v1.RouteTableSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2184,6 +2757,7 @@ func autoConvert_v1_RouteTableSpec_To_v20191101_RouteTableSpec(in *v1.RouteTable
 	out.Location = in.Location
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.Properties = (*RouteTableSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2229,6 +2803,8 @@ func autoConvert_v1_RouteTableStatus_To_v20191101_RouteTableStatus(in *v1.RouteT
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -2313,6 +2889,7 @@ func Convert_v1_SecurityRuleList_To_v20191101_SecurityRuleList(in *v1.SecurityRu
 
 func autoConvert_v20191101_SecurityRuleSpec_To_v1_SecurityRuleSpec(in *SecurityRuleSpec, out *v1.SecurityRuleSpec, s conversion.Scope) error {
 	out.Properties = (*v1.SecurityRuleSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2324,6 +2901,7 @@ func Convert_v20191101_SecurityRuleSpec_To_v1_SecurityRuleSpec(in *SecurityRuleS
 func autoConvert_v1_SecurityRuleSpec_To_v20191101_SecurityRuleSpec(in *v1.SecurityRuleSpec, out *SecurityRuleSpec, s conversion.Scope) error {
 	// INFO: in.APIVersion opted out of conversion generation
 	out.Properties = (*SecurityRuleSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2391,6 +2969,8 @@ func autoConvert_v1_SecurityRuleStatus_To_v20191101_SecurityRuleStatus(in *v1.Se
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -2499,6 +3079,7 @@ func autoConvert_v20191101_SubnetSpec_To_v1_SubnetSpec(in *SubnetSpec, out *v1.S
 	if err := Convert_v20191101_SubnetProperties_To_v1_SubnetProperties(&in.Properties, &out.Properties, s); err != nil {
 		return err
 	}
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2512,6 +3093,8 @@ func autoConvert_v1_SubnetSpec_To_v20191101_SubnetSpec(in *v1.SubnetSpec, out *S
 	if err := Convert_v1_SubnetProperties_To_v20191101_SubnetProperties(&in.Properties, &out.Properties, s); err != nil {
 		return err
 	}
+	// INFO: in.AddressPrefixLength opted out of conversion generation
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2535,6 +3118,10 @@ func autoConvert_v1_SubnetStatus_To_v20191101_SubnetStatus(in *v1.SubnetStatus,
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.IPConfigurations opted out of conversion generation
+	// INFO: in.AllocatedAddressPrefix opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -2617,11 +3204,165 @@ func Convert_v1_VirtualNetworkList_To_v20191101_VirtualNetworkList(in *v1.Virtua
 	return autoConvert_v1_VirtualNetworkList_To_v20191101_VirtualNetworkList(in, out, s)
 }
 
+func autoConvert_v20191101_VirtualNetworkPeering_To_v1_VirtualNetworkPeering(in *VirtualNetworkPeering, out *v1.VirtualNetworkPeering, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v20191101_VirtualNetworkPeeringSpec_To_v1_VirtualNetworkPeeringSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v20191101_VirtualNetworkPeeringStatus_To_v1_VirtualNetworkPeeringStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v20191101_VirtualNetworkPeering_To_v1_VirtualNetworkPeering is an autogenerated conversion function.
+func Convert_v20191101_VirtualNetworkPeering_To_v1_VirtualNetworkPeering(in *VirtualNetworkPeering, out *v1.VirtualNetworkPeering, s conversion.Scope) error {
+	return autoConvert_v20191101_VirtualNetworkPeering_To_v1_VirtualNetworkPeering(in, out, s)
+}
+
+func autoConvert_v1_VirtualNetworkPeering_To_v20191101_VirtualNetworkPeering(in *v1.VirtualNetworkPeering, out *VirtualNetworkPeering, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_VirtualNetworkPeeringSpec_To_v20191101_VirtualNetworkPeeringSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_VirtualNetworkPeeringStatus_To_v20191101_VirtualNetworkPeeringStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_VirtualNetworkPeering_To_v20191101_VirtualNetworkPeering is an autogenerated conversion function.
+func Convert_v1_VirtualNetworkPeering_To_v20191101_VirtualNetworkPeering(in *v1.VirtualNetworkPeering, out *VirtualNetworkPeering, s conversion.Scope) error {
+	return autoConvert_v1_VirtualNetworkPeering_To_v20191101_VirtualNetworkPeering(in, out, s)
+}
+
+func autoConvert_v20191101_VirtualNetworkPeeringList_To_v1_VirtualNetworkPeeringList(in *VirtualNetworkPeeringList, out *v1.VirtualNetworkPeeringList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.VirtualNetworkPeering, len(*in))
+		for i := range *in {
+			if err := Convert_v20191101_VirtualNetworkPeering_To_v1_VirtualNetworkPeering(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v20191101_VirtualNetworkPeeringList_To_v1_VirtualNetworkPeeringList is an autogenerated conversion function.
+func Convert_v20191101_VirtualNetworkPeeringList_To_v1_VirtualNetworkPeeringList(in *VirtualNetworkPeeringList, out *v1.VirtualNetworkPeeringList, s conversion.Scope) error {
+	return autoConvert_v20191101_VirtualNetworkPeeringList_To_v1_VirtualNetworkPeeringList(in, out, s)
+}
+
+func autoConvert_v1_VirtualNetworkPeeringList_To_v20191101_VirtualNetworkPeeringList(in *v1.VirtualNetworkPeeringList, out *VirtualNetworkPeeringList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualNetworkPeering, len(*in))
+		for i := range *in {
+			if err := Convert_v1_VirtualNetworkPeering_To_v20191101_VirtualNetworkPeering(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_VirtualNetworkPeeringList_To_v20191101_VirtualNetworkPeeringList is an autogenerated conversion function.
+func Convert_v1_VirtualNetworkPeeringList_To_v20191101_VirtualNetworkPeeringList(in *v1.VirtualNetworkPeeringList, out *VirtualNetworkPeeringList, s conversion.Scope) error {
+	return autoConvert_v1_VirtualNetworkPeeringList_To_v20191101_VirtualNetworkPeeringList(in, out, s)
+}
+
+func autoConvert_v20191101_VirtualNetworkPeeringSpec_To_v1_VirtualNetworkPeeringSpec(in *VirtualNetworkPeeringSpec, out *v1.VirtualNetworkPeeringSpec, s conversion.Scope) error {
+	out.Properties = (*v1.VirtualNetworkPeeringSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
+	return nil
+}
+
+// Convert_v20191101_VirtualNetworkPeeringSpec_To_v1_VirtualNetworkPeeringSpec is an autogenerated conversion function.
+func Convert_v20191101_VirtualNetworkPeeringSpec_To_v1_VirtualNetworkPeeringSpec(in *VirtualNetworkPeeringSpec, out *v1.VirtualNetworkPeeringSpec, s conversion.Scope) error {
+	return autoConvert_v20191101_VirtualNetworkPeeringSpec_To_v1_VirtualNetworkPeeringSpec(in, out, s)
+}
+
+func autoConvert_v1_VirtualNetworkPeeringSpec_To_v20191101_VirtualNetworkPeeringSpec(in *v1.VirtualNetworkPeeringSpec, out *VirtualNetworkPeeringSpec, s conversion.Scope) error {
+	// INFO: in.APIVersion opted out of conversion generation
+	out.Properties = (*VirtualNetworkPeeringSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
+	return nil
+}
+
+// Convert_v1_VirtualNetworkPeeringSpec_To_v20191101_VirtualNetworkPeeringSpec is an autogenerated conversion function.
+func Convert_v1_VirtualNetworkPeeringSpec_To_v20191101_VirtualNetworkPeeringSpec(in *v1.VirtualNetworkPeeringSpec, out *VirtualNetworkPeeringSpec, s conversion.Scope) error {
+	return autoConvert_v1_VirtualNetworkPeeringSpec_To_v20191101_VirtualNetworkPeeringSpec(in, out, s)
+}
+
+func autoConvert_v20191101_VirtualNetworkPeeringSpecProperties_To_v1_VirtualNetworkPeeringSpecProperties(in *VirtualNetworkPeeringSpecProperties, out *v1.VirtualNetworkPeeringSpecProperties, s conversion.Scope) error {
+	out.AllowVirtualNetworkAccess = (*bool)(unsafe.Pointer(in.AllowVirtualNetworkAccess))
+	out.AllowForwardedTraffic = in.AllowForwardedTraffic
+	out.AllowGatewayTransit = in.AllowGatewayTransit
+	out.UseRemoteGateways = in.UseRemoteGateways
+	out.RemoteVirtualNetworkRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.RemoteVirtualNetworkRef))
+	out.RemoteVirtualNetworkID = in.RemoteVirtualNetworkID
+	return nil
+}
+
+// Convert_v20191101_VirtualNetworkPeeringSpecProperties_To_v1_VirtualNetworkPeeringSpecProperties is an autogenerated conversion function.
+func Convert_v20191101_VirtualNetworkPeeringSpecProperties_To_v1_VirtualNetworkPeeringSpecProperties(in *VirtualNetworkPeeringSpecProperties, out *v1.VirtualNetworkPeeringSpecProperties, s conversion.Scope) error {
+	return autoConvert_v20191101_VirtualNetworkPeeringSpecProperties_To_v1_VirtualNetworkPeeringSpecProperties(in, out, s)
+}
+
+func autoConvert_v1_VirtualNetworkPeeringSpecProperties_To_v20191101_VirtualNetworkPeeringSpecProperties(in *v1.VirtualNetworkPeeringSpecProperties, out *VirtualNetworkPeeringSpecProperties, s conversion.Scope) error {
+	out.AllowVirtualNetworkAccess = (*bool)(unsafe.Pointer(in.AllowVirtualNetworkAccess))
+	out.AllowForwardedTraffic = in.AllowForwardedTraffic
+	out.AllowGatewayTransit = in.AllowGatewayTransit
+	out.UseRemoteGateways = in.UseRemoteGateways
+	out.RemoteVirtualNetworkRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.RemoteVirtualNetworkRef))
+	out.RemoteVirtualNetworkID = in.RemoteVirtualNetworkID
+	return nil
+}
+
+// Convert_v1_VirtualNetworkPeeringSpecProperties_To_v20191101_VirtualNetworkPeeringSpecProperties is an autogenerated conversion function.
+func Convert_v1_VirtualNetworkPeeringSpecProperties_To_v20191101_VirtualNetworkPeeringSpecProperties(in *v1.VirtualNetworkPeeringSpecProperties, out *VirtualNetworkPeeringSpecProperties, s conversion.Scope) error {
+	return autoConvert_v1_VirtualNetworkPeeringSpecProperties_To_v20191101_VirtualNetworkPeeringSpecProperties(in, out, s)
+}
+
+func autoConvert_v20191101_VirtualNetworkPeeringStatus_To_v1_VirtualNetworkPeeringStatus(in *VirtualNetworkPeeringStatus, out *v1.VirtualNetworkPeeringStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.ProvisioningState = in.ProvisioningState
+	return nil
+}
+
+// Convert_v20191101_VirtualNetworkPeeringStatus_To_v1_VirtualNetworkPeeringStatus is an autogenerated conversion function.
+func Convert_v20191101_VirtualNetworkPeeringStatus_To_v1_VirtualNetworkPeeringStatus(in *VirtualNetworkPeeringStatus, out *v1.VirtualNetworkPeeringStatus, s conversion.Scope) error {
+	return autoConvert_v20191101_VirtualNetworkPeeringStatus_To_v1_VirtualNetworkPeeringStatus(in, out, s)
+}
+
+func autoConvert_v1_VirtualNetworkPeeringStatus_To_v20191101_VirtualNetworkPeeringStatus(in *v1.VirtualNetworkPeeringStatus, out *VirtualNetworkPeeringStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	// INFO: in.DeploymentID opted out of conversion generation
+	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.PeeringState opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
+	return nil
+}
+
+// Convert_v1_VirtualNetworkPeeringStatus_To_v20191101_VirtualNetworkPeeringStatus is an autogenerated conversion function.
+func Convert_v1_VirtualNetworkPeeringStatus_To_v20191101_VirtualNetworkPeeringStatus(in *v1.VirtualNetworkPeeringStatus, out *VirtualNetworkPeeringStatus, s conversion.Scope) error {
+	return autoConvert_v1_VirtualNetworkPeeringStatus_To_v20191101_VirtualNetworkPeeringStatus(in, out, s)
+}
+
 func autoConvert_v20191101_VirtualNetworkSpec_To_v1_VirtualNetworkSpec(in *VirtualNetworkSpec, out *v1.VirtualNetworkSpec, s conversion.Scope) error {
 	out.ResourceGroupRef = (*corev1.KnownTypeReference)(unsafe.Pointer(in.ResourceGroupRef))
 	out.Location = in.Location
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.Properties = (*v1.VirtualNetworkSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2636,6 +3377,7 @@ func autoConvert_v1_VirtualNetworkSpec_To_v20191101_VirtualNetworkSpec(in *v1.Vi
 	out.Location = in.Location
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.Properties = (*VirtualNetworkSpecProperties)(unsafe.Pointer(in.Properties))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -2649,6 +3391,7 @@ func autoConvert_v20191101_VirtualNetworkSpecProperties_To_v1_VirtualNetworkSpec
 	out.BGPCommunities = (*v1.BGPCommunitiesSpec)(unsafe.Pointer(in.BGPCommunities))
 	out.DHCPOptions = (*v1.DHCPOptionsSpec)(unsafe.Pointer(in.DHCPOptions))
 	out.SubnetRefs = *(*[]corev1.KnownTypeReference)(unsafe.Pointer(&in.SubnetRefs))
+	out.VirtualNetworkPeeringRefs = *(*[]corev1.KnownTypeReference)(unsafe.Pointer(&in.VirtualNetworkPeeringRefs))
 	out.EnableVMProtection = in.EnableVMProtection
 	return nil
 }
@@ -2663,6 +3406,7 @@ func autoConvert_v1_VirtualNetworkSpecProperties_To_v20191101_VirtualNetworkSpec
 	out.BGPCommunities = (*BGPCommunitiesSpec)(unsafe.Pointer(in.BGPCommunities))
 	out.DHCPOptions = (*DHCPOptionsSpec)(unsafe.Pointer(in.DHCPOptions))
 	out.SubnetRefs = *(*[]corev1.KnownTypeReference)(unsafe.Pointer(&in.SubnetRefs))
+	out.VirtualNetworkPeeringRefs = *(*[]corev1.KnownTypeReference)(unsafe.Pointer(&in.VirtualNetworkPeeringRefs))
 	out.EnableVMProtection = in.EnableVMProtection
 	return nil
 }
@@ -2687,6 +3431,10 @@ func autoConvert_v1_VirtualNetworkStatus_To_v20191101_VirtualNetworkStatus(in *v
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.ETag opted out of conversion generation
+	// INFO: in.ResourceGUID opted out of conversion generation
+	// INFO: in.SubnetAllocations opted out of conversion generation
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -10,6 +10,7 @@ Licensed under the MIT license.
 package v20191101
 
 import (
+	corev1 "github.com/Azure/k8s-infra/apis/core/v1"
 	"github.com/Azure/k8s-infra/apis/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -116,6 +117,11 @@ func (in *BackendAddressPoolSpec) DeepCopyInto(out *BackendAddressPoolSpec) {
 		*out = new(BackendAddressPoolSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendAddressPoolSpec.
@@ -260,6 +266,11 @@ func (in *FrontendIPConfigurationSpec) DeepCopyInto(out *FrontendIPConfiguration
 		*out = new(FrontendIPConfigurationSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendIPConfigurationSpec.
@@ -384,6 +395,11 @@ func (in *InboundNatRuleSpec) DeepCopyInto(out *InboundNatRuleSpec) {
 		*out = new(InboundNatRuleSpecProperties)
 		**out = **in
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InboundNatRuleSpec.
@@ -525,6 +541,11 @@ func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 		*out = new(LoadBalancerSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
@@ -654,6 +675,11 @@ func (in *LoadBalancingRuleSpec) DeepCopyInto(out *LoadBalancingRuleSpec) {
 		*out = new(LoadBalancerRuleSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingRuleSpec.
@@ -681,6 +707,53 @@ func (in *LoadBalancingRuleStatus) DeepCopy() *LoadBalancingRuleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceDNSSettings) DeepCopyInto(out *NetworkInterfaceDNSSettings) {
+	*out = *in
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceDNSSettings.
+func (in *NetworkInterfaceDNSSettings) DeepCopy() *NetworkInterfaceDNSSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceDNSSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterfaceIPConfiguration) DeepCopyInto(out *NetworkInterfaceIPConfiguration) {
 	*out = *in
@@ -748,6 +821,11 @@ func (in *NetworkInterfaceIPConfigurationSpec) DeepCopyInto(out *NetworkInterfac
 		*out = new(NetworkInterfaceIPConfigurationSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceIPConfigurationSpec.
@@ -800,6 +878,120 @@ func (in *NetworkInterfaceIPConfigurationStatus) DeepCopy() *NetworkInterfaceIPC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceList) DeepCopyInto(out *NetworkInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceList.
+func (in *NetworkInterfaceList) DeepCopy() *NetworkInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
+	*out = *in
+	if in.ResourceGroupRef != nil {
+		in, out := &in.ResourceGroupRef, &out.ResourceGroupRef
+		*out = new(v1.KnownTypeReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(NetworkInterfaceSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpec.
+func (in *NetworkInterfaceSpec) DeepCopy() *NetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpecProperties) DeepCopyInto(out *NetworkInterfaceSpecProperties) {
+	*out = *in
+	if in.DNSSettings != nil {
+		in, out := &in.DNSSettings, &out.DNSSettings
+		*out = new(NetworkInterfaceDNSSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPConfigurationRefs != nil {
+		in, out := &in.IPConfigurationRefs, &out.IPConfigurationRefs
+		*out = make([]v1.KnownTypeReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkSecurityGroupRef != nil {
+		in, out := &in.NetworkSecurityGroupRef, &out.NetworkSecurityGroupRef
+		*out = new(v1.KnownTypeReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpecProperties.
+func (in *NetworkInterfaceSpecProperties) DeepCopy() *NetworkInterfaceSpecProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpecProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceStatus.
+func (in *NetworkInterfaceStatus) DeepCopy() *NetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSecurityGroup) DeepCopyInto(out *NetworkSecurityGroup) {
 	*out = *in
@@ -879,6 +1071,11 @@ func (in *NetworkSecurityGroupSpec) DeepCopyInto(out *NetworkSecurityGroupSpec)
 		*out = new(NetworkSecurityGroupSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSecurityGroupSpec.
@@ -993,6 +1190,11 @@ func (in *OutboundRuleSpec) DeepCopyInto(out *OutboundRuleSpec) {
 		*out = new(OutboundRuleSpecProperties)
 		**out = **in
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundRuleSpec.
@@ -1035,6 +1237,157 @@ func (in *OutboundRuleStatus) DeepCopy() *OutboundRuleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddress) DeepCopyInto(out *PublicIPAddress) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddress.
+func (in *PublicIPAddress) DeepCopy() *PublicIPAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicIPAddress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressDNSSettings) DeepCopyInto(out *PublicIPAddressDNSSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressDNSSettings.
+func (in *PublicIPAddressDNSSettings) DeepCopy() *PublicIPAddressDNSSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressDNSSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressList) DeepCopyInto(out *PublicIPAddressList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublicIPAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressList.
+func (in *PublicIPAddressList) DeepCopy() *PublicIPAddressList {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicIPAddressList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressSpec) DeepCopyInto(out *PublicIPAddressSpec) {
+	*out = *in
+	if in.ResourceGroupRef != nil {
+		in, out := &in.ResourceGroupRef, &out.ResourceGroupRef
+		*out = new(v1.KnownTypeReference)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(PublicIPAddressSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressSpec.
+func (in *PublicIPAddressSpec) DeepCopy() *PublicIPAddressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressSpecProperties) DeepCopyInto(out *PublicIPAddressSpecProperties) {
+	*out = *in
+	if in.DNSSettings != nil {
+		in, out := &in.DNSSettings, &out.DNSSettings
+		*out = new(PublicIPAddressDNSSettings)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressSpecProperties.
+func (in *PublicIPAddressSpecProperties) DeepCopy() *PublicIPAddressSpecProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressSpecProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressStatus) DeepCopyInto(out *PublicIPAddressStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressStatus.
+func (in *PublicIPAddressStatus) DeepCopy() *PublicIPAddressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
@@ -1102,6 +1455,11 @@ func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
 		*out = new(RouteSpecProperties)
 		**out = **in
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
@@ -1223,6 +1581,11 @@ func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 		*out = new(RouteTableSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
@@ -1337,6 +1700,11 @@ func (in *SecurityRuleSpec) DeepCopyInto(out *SecurityRuleSpec) {
 		*out = new(SecurityRuleSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRuleSpec.
@@ -1472,6 +1840,11 @@ func (in *SubnetProperties) DeepCopy() *SubnetProperties {
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
 	in.Properties.DeepCopyInto(&out.Properties)
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
@@ -1558,6 +1931,130 @@ func (in *VirtualNetworkList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeering) DeepCopyInto(out *VirtualNetworkPeering) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeering.
+func (in *VirtualNetworkPeering) DeepCopy() *VirtualNetworkPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualNetworkPeering) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeeringList) DeepCopyInto(out *VirtualNetworkPeeringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualNetworkPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeeringList.
+func (in *VirtualNetworkPeeringList) DeepCopy() *VirtualNetworkPeeringList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeeringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualNetworkPeeringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeeringSpec) DeepCopyInto(out *VirtualNetworkPeeringSpec) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(VirtualNetworkPeeringSpecProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeeringSpec.
+func (in *VirtualNetworkPeeringSpec) DeepCopy() *VirtualNetworkPeeringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeeringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeeringSpecProperties) DeepCopyInto(out *VirtualNetworkPeeringSpecProperties) {
+	*out = *in
+	if in.AllowVirtualNetworkAccess != nil {
+		in, out := &in.AllowVirtualNetworkAccess, &out.AllowVirtualNetworkAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoteVirtualNetworkRef != nil {
+		in, out := &in.RemoteVirtualNetworkRef, &out.RemoteVirtualNetworkRef
+		*out = new(v1.KnownTypeReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeeringSpecProperties.
+func (in *VirtualNetworkPeeringSpecProperties) DeepCopy() *VirtualNetworkPeeringSpecProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeeringSpecProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualNetworkPeeringStatus) DeepCopyInto(out *VirtualNetworkPeeringStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkPeeringStatus.
+func (in *VirtualNetworkPeeringStatus) DeepCopy() *VirtualNetworkPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualNetworkPeeringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualNetworkSpec) DeepCopyInto(out *VirtualNetworkSpec) {
 	*out = *in
@@ -1578,6 +2075,11 @@ func (in *VirtualNetworkSpec) DeepCopyInto(out *VirtualNetworkSpec) {
 		*out = new(VirtualNetworkSpecProperties)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkSpec.
@@ -1613,6 +2115,11 @@ func (in *VirtualNetworkSpecProperties) DeepCopyInto(out *VirtualNetworkSpecProp
 		*out = make([]v1.KnownTypeReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.VirtualNetworkPeeringRefs != nil {
+		in, out := &in.VirtualNetworkPeeringRefs, &out.VirtualNetworkPeeringRefs
+		*out = make([]v1.KnownTypeReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualNetworkSpecProperties.
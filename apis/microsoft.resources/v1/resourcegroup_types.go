@@ -7,15 +7,21 @@ package v1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
 
 // ResourceGroupSpec defines the desired state of ResourceGroup
 type ResourceGroupSpec struct {
 	// +k8s:conversion-gen=false
 	APIVersion string            `json:"apiVersion,omitempty"`
-	Location   string            `json:"location,omitempty"`
+	Location   string            `json:"location,omitempty" immutable:"true"`
 	ManagedBy  string            `json:"managedBy,omitempty"`
 	Tags       map[string]string `json:"tags,omitempty"`
+
+	// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+	// +optional
+	Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 }
 
 // ResourceGroupStatus defines the observed state of ResourceGroup
@@ -24,6 +30,9 @@ type ResourceGroupStatus struct {
 	// +k8s:conversion-gen=false
 	DeploymentID      string `json:"deploymentId,omitempty"`
 	ProvisioningState string `json:"provisioningState,omitempty"`
+	// Conditions describe the state of the resource group which the provisioning state alone does not capture
+	// +k8s:conversion-gen=false
+	Conditions azcorev1.Conditions `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -52,6 +61,18 @@ func (rt *ResourceGroup) ResourceType() string {
 	return "Microsoft.Resources/resourceGroups"
 }
 
+func (rt *ResourceGroup) GetOutputs() *azcorev1.OutputsSpec {
+	return rt.Spec.Outputs
+}
+
+func (rt *ResourceGroup) GetConditions() azcorev1.Conditions {
+	return rt.Status.Conditions
+}
+
+func (rt *ResourceGroup) SetConditions(conditions azcorev1.Conditions) {
+	rt.Status.Conditions = conditions
+}
+
 func init() {
 	SchemeBuilder.Register(&ResourceGroup{}, &ResourceGroupList{})
 }
@@ -6,11 +6,19 @@ Licensed under the MIT license.
 package v1
 
 import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
 
+// defaultAPIVersion is the Microsoft.Resources API version of the newest version of the group
+const defaultAPIVersion = "2019-10-01"
+
 // log is for logging in this package.
 var resourcegrouplog = logf.Log.WithName("resourcegroup-resource")
 
@@ -22,11 +30,13 @@ var _ webhook.Defaulter = &ResourceGroup{}
 func (r *ResourceGroup) Default() {
 	resourcegrouplog.Info("default", "name", r.Name)
 
-	// TODO(user): fill in your defaulting logic.
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
-// +kubebuilder:webhook:verbs=create;update,path=/validate-microsoft-resources-infra-azure-com-v1-resourcegroup,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=microsoft.resources.infra.azure.com,resources=resourcegroups,versions=v1,name=validation.resourcegroup.infra.azure.com
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-resources-infra-azure-com-v1-resourcegroup,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=microsoft.resources.infra.azure.com,resources=resourcegroups,versions=v1,name=validation.resourcegroup.infra.azure.com
 
 var _ webhook.Validator = &ResourceGroup{}
 
@@ -42,7 +52,14 @@ func (r *ResourceGroup) ValidateCreate() error {
 func (r *ResourceGroup) ValidateUpdate(old runtime.Object) error {
 	resourcegrouplog.Info("validate update", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object update.
+	oldRG, ok := old.(*ResourceGroup)
+	if !ok {
+		return fmt.Errorf("expected old object to be a ResourceGroup, but was %T", old)
+	}
+
+	if allErrs := azcorev1.ValidateImmutableFields(oldRG, r); len(allErrs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("ResourceGroup").GroupKind(), r.Name, allErrs)
+	}
 	return nil
 }
 
@@ -50,6 +67,5 @@ func (r *ResourceGroup) ValidateUpdate(old runtime.Object) error {
 func (r *ResourceGroup) ValidateDelete() error {
 	resourcegrouplog.Info("validate delete", "name", r.Name)
 
-	// TODO(user): fill in your validation logic upon object deletion.
-	return nil
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("ResourceGroup").GroupKind(), r)
 }
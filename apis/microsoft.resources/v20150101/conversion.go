@@ -9,17 +9,23 @@ import (
 	cvt "k8s.io/apimachinery/pkg/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 	v1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
 )
 
 func (src *ResourceGroup) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.ResourceGroup)
 
+	dst.Spec.APIVersion = "2015-01-01"
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20150101_ResourceGroup_To_v1_ResourceGroup(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = "2015-01-01"
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -30,7 +36,7 @@ func (dst *ResourceGroup) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
 
 // Convert_v1_ResourceGroupSpec_To_v20150101_ResourceGroupSpec is required because we are unable to project MangedBy
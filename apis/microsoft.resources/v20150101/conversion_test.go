@@ -0,0 +1,17 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20150101
+
+import (
+	"testing"
+
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
+	"github.com/Azure/k8s-infra/internal/test"
+)
+
+func TestFuzzyConversion(t *testing.T) {
+	test.FuzzConversion(t, &v1.ResourceGroup{}, &ResourceGroup{})
+}
@@ -11,6 +11,7 @@ package v20150101
 import (
 	unsafe "unsafe"
 
+	corev1 "github.com/Azure/k8s-infra/apis/core/v1"
 	v1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -143,6 +144,7 @@ func Convert_v1_ResourceGroupList_To_v20150101_ResourceGroupList(in *v1.Resource
 func autoConvert_v20150101_ResourceGroupSpec_To_v1_ResourceGroupSpec(in *ResourceGroupSpec, out *v1.ResourceGroupSpec, s conversion.Scope) error {
 	out.Location = in.Location
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -156,6 +158,7 @@ func autoConvert_v1_ResourceGroupSpec_To_v20150101_ResourceGroupSpec(in *v1.Reso
 	out.Location = in.Location
 	// WARNING: in.ManagedBy requires manual conversion: does not exist in peer-type
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -174,6 +177,7 @@ func autoConvert_v1_ResourceGroupStatus_To_v20150101_ResourceGroupStatus(in *v1.
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
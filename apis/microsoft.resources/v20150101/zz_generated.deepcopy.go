@@ -10,6 +10,7 @@ Licensed under the MIT license.
 package v20150101
 
 import (
+	corev1 "github.com/Azure/k8s-infra/apis/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -82,6 +83,11 @@ func (in *ResourceGroupSpec) DeepCopyInto(out *ResourceGroupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(corev1.OutputsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroupSpec.
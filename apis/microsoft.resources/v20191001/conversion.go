@@ -8,17 +8,23 @@ package v20191001
 import (
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 	v1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
 )
 
 func (src *ResourceGroup) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1.ResourceGroup)
 
+	dst.Spec.APIVersion = "2019-10-01"
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
 	if err := Convert_v20191001_ResourceGroup_To_v1_ResourceGroup(src, dst, nil); err != nil {
 		return err
 	}
 
-	dst.Spec.APIVersion = "2019-10-01"
+	azcorev1.RemoveConversionData(dst)
 	return nil
 }
 
@@ -29,5 +35,5 @@ func (dst *ResourceGroup) ConvertFrom(srcRaw conversion.Hub) error {
 		return err
 	}
 
-	return nil
+	return azcorev1.StoreConversionData(src, dst)
 }
@@ -7,6 +7,8 @@ package v20191001
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
 
 type (
@@ -22,6 +24,10 @@ type (
 		// Tags are user defined key value pairs
 		// +optional
 		Tags map[string]string `json:"tags,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
 	}
 
 	// ResourceGroupStatus defines the observed state of ResourceGroup
@@ -11,6 +11,7 @@ package v20191001
 import (
 	unsafe "unsafe"
 
+	corev1 "github.com/Azure/k8s-infra/apis/core/v1"
 	v1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -144,6 +145,7 @@ func autoConvert_v20191001_ResourceGroupSpec_To_v1_ResourceGroupSpec(in *Resourc
 	out.Location = in.Location
 	out.ManagedBy = in.ManagedBy
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -157,6 +159,7 @@ func autoConvert_v1_ResourceGroupSpec_To_v20191001_ResourceGroupSpec(in *v1.Reso
 	out.Location = in.Location
 	out.ManagedBy = in.ManagedBy
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Outputs = (*corev1.OutputsSpec)(unsafe.Pointer(in.Outputs))
 	return nil
 }
 
@@ -180,6 +183,7 @@ func autoConvert_v1_ResourceGroupStatus_To_v20191001_ResourceGroupStatus(in *v1.
 	out.ID = in.ID
 	// INFO: in.DeploymentID opted out of conversion generation
 	out.ProvisioningState = in.ProvisioningState
+	// INFO: in.Conditions opted out of conversion generation
 	return nil
 }
 
@@ -0,0 +1,9 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+func (*BlobContainer) Hub()  {}
+func (*StorageAccount) Hub() {}
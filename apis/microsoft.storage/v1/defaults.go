@@ -0,0 +1,15 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+const (
+	// defaultAPIVersion is the Microsoft.Storage API version of the newest version of the group
+	defaultAPIVersion = "2019-06-01"
+	// defaultSKU is the cheapest replication, which is also supported in every region
+	defaultSKU = "Standard_LRS"
+	// defaultKind is the general purpose account kind which supports every service and access tier
+	defaultKind = "StorageV2"
+)
@@ -0,0 +1,46 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+func (sa *StorageAccount) GetResourceGroupObjectRef() *azcorev1.KnownTypeReference {
+	return sa.Spec.ResourceGroupRef
+}
+
+func (*BlobContainer) ResourceType() string {
+	return "Microsoft.Storage/storageAccounts/blobServices/containers"
+}
+
+func (*StorageAccount) ResourceType() string {
+	return "Microsoft.Storage/storageAccounts"
+}
+
+func (bc *BlobContainer) GetOutputs() *azcorev1.OutputsSpec {
+	return bc.Spec.Outputs
+}
+
+func (sa *StorageAccount) GetOutputs() *azcorev1.OutputsSpec {
+	return sa.Spec.Outputs
+}
+
+func (bc *BlobContainer) GetConditions() azcorev1.Conditions {
+	return bc.Status.Conditions
+}
+
+func (bc *BlobContainer) SetConditions(conditions azcorev1.Conditions) {
+	bc.Status.Conditions = conditions
+}
+
+func (sa *StorageAccount) GetConditions() azcorev1.Conditions {
+	return sa.Status.Conditions
+}
+
+func (sa *StorageAccount) SetConditions(conditions azcorev1.Conditions) {
+	sa.Status.Conditions = conditions
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+const (
+	listKeysAction = "listKeys"
+	// defaultEndpointSuffix is the endpoint suffix of storage accounts in the public cloud, used until the endpoints
+	// of the account are known
+	defaultEndpointSuffix = "core.windows.net"
+
+	accountNameSecretKey      = "accountName"
+	accountKeySecretKey       = "accountKey"
+	connectionStringSecretKey = "connectionString"
+)
+
+type (
+	// +kubebuilder:object:generate=false
+	storageAccountListKeysResult struct {
+		Keys []struct {
+			KeyName string `json:"keyName"`
+			Value   string `json:"value"`
+		} `json:"keys"`
+	}
+)
+
+var _ azcorev1.SecretsExporter = &StorageAccount{}
+
+// ExportSecrets lists the access keys of the StorageAccount and returns the account name, the first key and a
+// connection string built from them
+func (sa *StorageAccount) ExportSecrets(ctx context.Context, invoke azcorev1.ARMActionFunc) (map[string]string, error) {
+	body, err := invoke(ctx, listKeysAction)
+	if err != nil {
+		return nil, err
+	}
+
+	var result storageAccountListKeysResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal storage account keys with: %w", err)
+	}
+
+	if len(result.Keys) == 0 {
+		return nil, errors.New("storage account has no access keys")
+	}
+
+	key := result.Keys[0].Value
+	return map[string]string{
+		accountNameSecretKey: sa.Name,
+		accountKeySecretKey:  key,
+		connectionStringSecretKey: fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s",
+			sa.Name, key, sa.endpointSuffix()),
+	}, nil
+}
+
+// endpointSuffix returns the suffix of the endpoints of the account, which depends on the cloud it was created in,
+// eg. core.windows.net for https://account.blob.core.windows.net/
+func (sa *StorageAccount) endpointSuffix() string {
+	if sa.Status.PrimaryEndpoints == nil || sa.Status.PrimaryEndpoints.Blob == "" {
+		return defaultEndpointSuffix
+	}
+
+	u, err := url.Parse(sa.Status.PrimaryEndpoints.Blob)
+	if err != nil {
+		return defaultEndpointSuffix
+	}
+
+	prefix := sa.Name + ".blob."
+	if !strings.HasPrefix(u.Hostname(), prefix) {
+		return defaultEndpointSuffix
+	}
+
+	return strings.TrimPrefix(u.Hostname(), prefix)
+}
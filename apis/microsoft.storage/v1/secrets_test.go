@@ -0,0 +1,51 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStorageAccount_ExportSecrets(t *testing.T) {
+	sa := &StorageAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "sa"},
+		Status: StorageAccountStatus{
+			PrimaryEndpoints: &StorageAccountEndpoints{Blob: "https://sa.blob.core.chinacloudapi.cn/"},
+		},
+	}
+
+	var invoked string
+	invoke := func(_ context.Context, action string) (json.RawMessage, error) {
+		invoked = action
+		return json.RawMessage(`{"keys":[{"keyName":"key1","value":"a2V5MQ=="},{"keyName":"key2","value":"a2V5Mg=="}]}`), nil
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	secrets, err := sa.ExportSecrets(context.Background(), invoke)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(invoked).To(gomega.Equal("listKeys"))
+	g.Expect(secrets).To(gomega.Equal(map[string]string{
+		"accountName":      "sa",
+		"accountKey":       "a2V5MQ==",
+		"connectionString": "DefaultEndpointsProtocol=https;AccountName=sa;AccountKey=a2V5MQ==;EndpointSuffix=core.chinacloudapi.cn",
+	}))
+}
+
+func TestStorageAccount_ExportSecrets_NoKeys(t *testing.T) {
+	sa := &StorageAccount{ObjectMeta: metav1.ObjectMeta{Name: "sa"}}
+	invoke := func(_ context.Context, _ string) (json.RawMessage, error) {
+		return json.RawMessage(`{"keys":[]}`), nil
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	_, err := sa.ExportSecrets(context.Background(), invoke)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
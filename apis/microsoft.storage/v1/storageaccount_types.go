@@ -0,0 +1,112 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// StorageAccountSpecProperties are the resource specific properties
+	StorageAccountSpecProperties struct {
+		// AccessTier is the default access tier of blobs in the account, which is required for BlobStorage accounts
+		// +kubebuilder:validation:Enum=Hot;Cool
+		AccessTier string `json:"accessTier,omitempty"`
+		// BlobContainerRefs are the blob containers of the storage account
+		BlobContainerRefs []azcorev1.KnownTypeReference `json:"blobContainerRefs,omitempty" group:"microsoft.storage.infra.azure.com" kind:"BlobContainer" owned:"true" arm:"-"`
+		// IsHNSEnabled enables the hierarchical namespace of Data Lake Storage Gen2
+		IsHNSEnabled bool `json:"isHnsEnabled,omitempty" immutable:"true"`
+		// +kubebuilder:validation:Enum=TLS1_0;TLS1_1;TLS1_2
+		MinimumTLSVersion        string `json:"minimumTlsVersion,omitempty"`
+		SupportsHTTPSTrafficOnly *bool  `json:"supportsHttpsTrafficOnly,omitempty"`
+	}
+
+	// StorageAccountEndpoints are the URLs of the services of the storage account
+	StorageAccountEndpoints struct {
+		Blob  string `json:"blob,omitempty"`
+		DFS   string `json:"dfs,omitempty"`
+		File  string `json:"file,omitempty"`
+		Queue string `json:"queue,omitempty"`
+		Table string `json:"table,omitempty"`
+		Web   string `json:"web,omitempty"`
+	}
+
+	// StorageAccountSpec defines the desired state of StorageAccount
+	StorageAccountSpec struct {
+		// +k8s:conversion-gen=false
+		APIVersion string `json:"apiVersion"`
+
+		// ResourceGroupRef is the Azure Resource Group the StorageAccount resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup" immutable:"true"`
+
+		// Location of the StorageAccount in Azure
+		// +kubebuilder:validation:Required
+		Location string `json:"location" immutable:"true"`
+
+		// SKU is the replication of the StorageAccount
+		// +kubebuilder:validation:Enum=Standard_LRS;Standard_GRS;Standard_RAGRS;Standard_ZRS;Standard_GZRS;Standard_RAGZRS;Premium_LRS;Premium_ZRS
+		SKU string `json:"sku,omitempty"`
+
+		// Kind of the StorageAccount, which determines the services and access tiers it supports
+		// +kubebuilder:validation:Enum=Storage;StorageV2;BlobStorage;FileStorage;BlockBlobStorage
+		Kind string `json:"kind,omitempty"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the StorageAccount
+		Properties *StorageAccountSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret. The account name, key and
+		// connection string are also written to the Secret, if one is named.
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// StorageAccountStatus defines the observed state of StorageAccount
+	StorageAccountStatus struct {
+		ID string `json:"id,omitempty"`
+		// +k8s:conversion-gen=false
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+		// PrimaryEndpoints are the URLs of the services of the storage account in its primary location
+		// +k8s:conversion-gen=false
+		PrimaryEndpoints *StorageAccountEndpoints `json:"primaryEndpoints,omitempty" arm:"primaryEndpoints"`
+		// Conditions describe the state of the resource which the provisioning state alone does not capture
+		// +k8s:conversion-gen=false
+		Conditions azcorev1.Conditions `json:"conditions,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+	// +kubebuilder:subresource:status
+	// +kubebuilder:storageversion
+
+	// StorageAccount is the Schema for the storageaccounts API
+	StorageAccount struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   StorageAccountSpec   `json:"spec,omitempty"`
+		Status StorageAccountStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// StorageAccountList contains a list of StorageAccount
+	StorageAccountList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []StorageAccount `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&StorageAccount{}, &StorageAccountList{})
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+const (
+	storageAccountKindBlobStorage = "BlobStorage"
+)
+
+var (
+	// supportedAPIVersions are the Microsoft.Storage API versions the types in this group are able to apply
+	supportedAPIVersions = []string{defaultAPIVersion}
+
+	// storageAccountNameRegex matches the names Azure allows for storage accounts, which are part of the DNS names of
+	// their endpoints
+	storageAccountNameRegex = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+	// blobContainerNameRegex matches the names Azure allows for blob containers
+	blobContainerNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9]|-[a-z0-9]){2,62}$`)
+)
+
+func (r *BlobContainer) validateSpec() field.ErrorList {
+	allErrs := validateName(r.Name, blobContainerNameRegex, "3 to 63 lowercase letters, numbers and single hyphens")
+	return append(allErrs, azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, field.NewPath("spec", "apiVersion"))...)
+}
+
+func (r *StorageAccount) validateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := validateName(r.Name, storageAccountNameRegex, "3 to 24 lowercase letters and numbers")
+	allErrs = append(allErrs, azcorev1.ValidateAPIVersion(r.Spec.APIVersion, supportedAPIVersions, specPath.Child("apiVersion"))...)
+
+	if r.Spec.Kind == storageAccountKindBlobStorage && (r.Spec.Properties == nil || r.Spec.Properties.AccessTier == "") {
+		allErrs = append(allErrs, field.Required(specPath.Child("properties", "accessTier"), "required when kind is BlobStorage"))
+	}
+
+	return allErrs
+}
+
+// validateName validates the name of the object, which is the name of the resource in Azure
+func validateName(name string, regex *regexp.Regexp, expected string) field.ErrorList {
+	if regex.MatchString(name) {
+		return nil
+	}
+
+	return field.ErrorList{field.Invalid(field.NewPath("metadata", "name"), name, "must be "+expected)}
+}
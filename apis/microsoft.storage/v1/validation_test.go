@@ -0,0 +1,72 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStorageAccount_ValidateCreate(t *testing.T) {
+	cases := []struct {
+		Name         string
+		AccountName  string
+		Kind         string
+		ExpectFields []string
+	}{
+		{
+			Name:        "Valid",
+			AccountName: "sa0123",
+			Kind:        "StorageV2",
+		},
+		{
+			Name:         "InvalidName",
+			AccountName:  "My-Account",
+			Kind:         "StorageV2",
+			ExpectFields: []string{"metadata.name"},
+		},
+		{
+			Name:         "BlobStorageWithoutAccessTier",
+			AccountName:  "sa0123",
+			Kind:         "BlobStorage",
+			ExpectFields: []string{"spec.properties.accessTier"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			sa := &StorageAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: c.AccountName},
+				Spec: StorageAccountSpec{
+					APIVersion: "2019-06-01",
+					Kind:       c.Kind,
+				},
+			}
+
+			g := gomega.NewGomegaWithT(t)
+			expectInvalidFields(g, sa.ValidateCreate(), c.ExpectFields)
+		})
+	}
+}
+
+func expectInvalidFields(g *gomega.GomegaWithT, err error, fields []string) {
+	if len(fields) == 0 {
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		return
+	}
+
+	g.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue(), "expected an Invalid error, but got %v", err)
+	status := err.(apierrors.APIStatus).Status()
+	var actual []string
+	for _, cause := range status.Details.Causes {
+		actual = append(actual, cause.Field)
+	}
+	g.Expect(actual).To(gomega.ConsistOf(fields))
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+// log is for logging in this package.
+var blobcontainerlog = logf.Log.WithName("blobcontainer-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-storage-infra-azure-com-v1-blobcontainer,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.storage.infra.azure.com,resources=blobcontainers,verbs=create;update,versions=v1,name=default.blobcontainer.infra.azure.com
+
+var _ webhook.Defaulter = &BlobContainer{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *BlobContainer) Default() {
+	blobcontainerlog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-storage-infra-azure-com-v1-blobcontainer,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.storage.infra.azure.com,resources=blobcontainers,versions=v1,name=validation.blobcontainer.infra.azure.com
+
+var _ webhook.Validator = &BlobContainer{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *BlobContainer) ValidateCreate() error {
+	blobcontainerlog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("BlobContainer").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *BlobContainer) ValidateUpdate(old runtime.Object) error {
+	blobcontainerlog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("BlobContainer").GroupKind(), old, r, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *BlobContainer) ValidateDelete() error {
+	blobcontainerlog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("BlobContainer").GroupKind(), r)
+}
+
+// log is for logging in this package.
+var storageaccountlog = logf.Log.WithName("storageaccount-resource")
+
+// +kubebuilder:webhook:path=/mutate-microsoft-storage-infra-azure-com-v1-storageaccount,mutating=true,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.storage.infra.azure.com,resources=storageaccounts,verbs=create;update,versions=v1,name=default.storageaccount.infra.azure.com
+
+var _ webhook.Defaulter = &StorageAccount{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *StorageAccount) Default() {
+	storageaccountlog.Info("default", "name", r.Name)
+
+	if r.Spec.APIVersion == "" {
+		r.Spec.APIVersion = defaultAPIVersion
+	}
+	if r.Spec.SKU == "" {
+		r.Spec.SKU = defaultSKU
+	}
+	if r.Spec.Kind == "" {
+		r.Spec.Kind = defaultKind
+	}
+	azcorev1.DefaultReferenceNamespaces(r)
+	r.Spec.Location = azcorev1.DefaultLocation(r.Spec.Location, r.Spec.ResourceGroupRef, r.Namespace)
+	r.Spec.Tags = azcorev1.DefaultTags(r.Spec.Tags)
+}
+
+// +kubebuilder:webhook:verbs=create;update;delete,path=/validate-microsoft-storage-infra-azure-com-v1-storageaccount,mutating=false,matchPolicy=Equivalent,failurePolicy=fail,groups=microsoft.storage.infra.azure.com,resources=storageaccounts,versions=v1,name=validation.storageaccount.infra.azure.com
+
+var _ webhook.Validator = &StorageAccount{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *StorageAccount) ValidateCreate() error {
+	storageaccountlog.Info("validate create", "name", r.Name)
+
+	return azcorev1.InvalidError(GroupVersion.WithKind("StorageAccount").GroupKind(), r.Name, r.validateSpec())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *StorageAccount) ValidateUpdate(old runtime.Object) error {
+	storageaccountlog.Info("validate update", "name", r.Name)
+
+	return azcorev1.ValidateUpdate(GroupVersion.WithKind("StorageAccount").GroupKind(), old, r, r.validateSpec())
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *StorageAccount) ValidateDelete() error {
+	storageaccountlog.Info("validate delete", "name", r.Name)
+
+	return azcorev1.ValidateDeletionAllowed(GroupVersion.WithKind("StorageAccount").GroupKind(), r)
+}
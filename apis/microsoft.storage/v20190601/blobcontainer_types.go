@@ -0,0 +1,63 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20190601
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// BlobContainerSpecProperties are the resource specific properties
+	BlobContainerSpecProperties struct {
+		// Metadata are name value pairs stored with the container
+		Metadata map[string]string `json:"metadata,omitempty"`
+		// PublicAccess is the level of anonymous read access to the data of the container
+		// +kubebuilder:validation:Enum=None;Container;Blob
+		PublicAccess string `json:"publicAccess,omitempty"`
+	}
+
+	// BlobContainerSpec defines the desired state of BlobContainer
+	BlobContainerSpec struct {
+		// Properties of the BlobContainer
+		Properties *BlobContainerSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// BlobContainerStatus defines the observed state of BlobContainer
+	BlobContainerStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// BlobContainer is the Schema for the blobcontainers API
+	BlobContainer struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   BlobContainerSpec   `json:"spec,omitempty"`
+		Status BlobContainerStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// BlobContainerList contains a list of BlobContainer
+	BlobContainerList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []BlobContainer `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&BlobContainer{}, &BlobContainerList{})
+}
@@ -0,0 +1,69 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20190601
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	v1 "github.com/Azure/k8s-infra/apis/microsoft.storage/v1"
+)
+
+const (
+	apiVersion = "2019-06-01"
+)
+
+func (src *BlobContainer) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.BlobContainer)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20190601_BlobContainer_To_v1_BlobContainer(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
+	return nil
+}
+
+func (dst *BlobContainer) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.BlobContainer)
+
+	if err := Convert_v1_BlobContainer_To_v20190601_BlobContainer(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
+
+func (src *StorageAccount) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.StorageAccount)
+
+	dst.Spec.APIVersion = apiVersion
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return err
+	}
+
+	if err := Convert_v20190601_StorageAccount_To_v1_StorageAccount(src, dst, nil); err != nil {
+		return err
+	}
+
+	azcorev1.RemoveConversionData(dst)
+	return nil
+}
+
+func (dst *StorageAccount) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.StorageAccount)
+
+	if err := Convert_v1_StorageAccount_To_v20190601_StorageAccount(src, dst, nil); err != nil {
+		return err
+	}
+
+	return azcorev1.StoreConversionData(src, dst)
+}
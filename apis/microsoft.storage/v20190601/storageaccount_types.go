@@ -0,0 +1,89 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package v20190601
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	// StorageAccountSpecProperties are the resource specific properties
+	StorageAccountSpecProperties struct {
+		// AccessTier is the default access tier of blobs in the account, which is required for BlobStorage accounts
+		// +kubebuilder:validation:Enum=Hot;Cool
+		AccessTier string `json:"accessTier,omitempty"`
+		// BlobContainerRefs are the blob containers of the storage account
+		BlobContainerRefs []azcorev1.KnownTypeReference `json:"blobContainerRefs,omitempty"`
+		// IsHNSEnabled enables the hierarchical namespace of Data Lake Storage Gen2
+		IsHNSEnabled bool `json:"isHnsEnabled,omitempty"`
+		// +kubebuilder:validation:Enum=TLS1_0;TLS1_1;TLS1_2
+		MinimumTLSVersion        string `json:"minimumTlsVersion,omitempty"`
+		SupportsHTTPSTrafficOnly *bool  `json:"supportsHttpsTrafficOnly,omitempty"`
+	}
+
+	// StorageAccountSpec defines the desired state of StorageAccount
+	StorageAccountSpec struct {
+		// ResourceGroupRef is the Azure Resource Group the StorageAccount resides within
+		// +kubebuilder:validation:Required
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef"`
+
+		// Location of the StorageAccount in Azure
+		// +kubebuilder:validation:Required
+		Location string `json:"location"`
+
+		// SKU is the replication of the StorageAccount
+		// +kubebuilder:validation:Enum=Standard_LRS;Standard_GRS;Standard_RAGRS;Standard_ZRS;Standard_GZRS;Standard_RAGZRS;Premium_LRS;Premium_ZRS
+		SKU string `json:"sku,omitempty"`
+
+		// Kind of the StorageAccount, which determines the services and access tiers it supports
+		// +kubebuilder:validation:Enum=Storage;StorageV2;BlobStorage;FileStorage;BlockBlobStorage
+		Kind string `json:"kind,omitempty"`
+
+		// Tags are user defined key value pairs
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+
+		// Properties of the StorageAccount
+		Properties *StorageAccountSpecProperties `json:"properties,omitempty"`
+
+		// Outputs are values of the Azure resource to export to a ConfigMap or Secret. The account name, key and
+		// connection string are also written to the Secret, if one is named.
+		// +optional
+		Outputs *azcorev1.OutputsSpec `json:"outputs,omitempty"`
+	}
+
+	// StorageAccountStatus defines the observed state of StorageAccount
+	StorageAccountStatus struct {
+		ID                string `json:"id,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// StorageAccount is the Schema for the storageaccounts API
+	StorageAccount struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   StorageAccountSpec   `json:"spec,omitempty"`
+		Status StorageAccountStatus `json:"status,omitempty"`
+	}
+
+	// +kubebuilder:object:root=true
+
+	// StorageAccountList contains a list of StorageAccount
+	StorageAccountList struct {
+		metav1.TypeMeta `json:",inline"`
+		metav1.ListMeta `json:"metadata,omitempty"`
+		Items           []StorageAccount `json:"items"`
+	}
+)
+
+func init() {
+	SchemeBuilder.Register(&StorageAccount{}, &StorageAccountList{})
+}
@@ -7,27 +7,38 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/Azure/k8s-infra/apis"
 	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	microsoftcomputev1 "github.com/Azure/k8s-infra/apis/microsoft.compute/v1"
+	microsoftkeyvaultv1 "github.com/Azure/k8s-infra/apis/microsoft.keyvault/v1"
 	microsoftnetworkv1 "github.com/Azure/k8s-infra/apis/microsoft.network/v1"
 	microsoftresourcesv1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
+	microsoftstoragev1 "github.com/Azure/k8s-infra/apis/microsoft.storage/v1"
 	"github.com/Azure/k8s-infra/pkg/util/patch"
 	"github.com/Azure/k8s-infra/pkg/util/statusutil"
 	"github.com/Azure/k8s-infra/pkg/xform"
@@ -37,6 +48,15 @@ import (
 const (
 	// ResourceSigAnnotationKey is an annotation key which holds the value of the hash of the spec
 	ResourceSigAnnotationKey = "resource-sig.infra.azure.com"
+	// ImmutableSigAnnotationKey is an annotation key which holds the value of the hash of the immutable fields of the
+	// spec when it was last applied
+	ImmutableSigAnnotationKey = "immutable-sig.infra.azure.com"
+	// OutputsOwnerLabelKey is a label key which holds the UID of the object the outputs in a ConfigMap or Secret were
+	// exported from
+	OutputsOwnerLabelKey = "outputs-owner.infra.azure.com"
+
+	// outputsRefreshInterval is how often the outputs of an unchanged resource are refreshed from Azure
+	outputsRefreshInterval = 5 * time.Minute
 )
 
 var (
@@ -55,22 +75,39 @@ var (
 		new(microsoftnetworkv1.InboundNatRule),
 		new(microsoftnetworkv1.LoadBalancer),
 		new(microsoftnetworkv1.LoadBalancingRule),
+		new(microsoftnetworkv1.NetworkInterface),
 		new(microsoftnetworkv1.NetworkInterfaceIPConfiguration),
 		new(microsoftnetworkv1.NetworkSecurityGroup),
 		new(microsoftnetworkv1.OutboundRule),
+		new(microsoftnetworkv1.PrivateDNSZone),
+		new(microsoftnetworkv1.PublicIPAddress),
 		new(microsoftnetworkv1.Route),
 		new(microsoftnetworkv1.RouteTable),
 		new(microsoftnetworkv1.SecurityRule),
 		new(microsoftnetworkv1.VirtualNetwork),
 		new(microsoftnetworkv1.Subnet),
+		new(microsoftnetworkv1.VirtualNetworkLink),
+		new(microsoftnetworkv1.VirtualNetworkPeering),
+		new(microsoftcomputev1.Disk),
+		new(microsoftcomputev1.VirtualMachine),
+		new(microsoftstoragev1.StorageAccount),
+		new(microsoftstoragev1.BlobContainer),
+		new(microsoftkeyvaultv1.Vault),
 	}
 )
 
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;patch
+// +kubebuilder:rbac:groups=core,resources=configmaps;secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=microsoft.resources.infra.azure.com,resources=resourcegroups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=microsoft.resources.infra.azure.com,resources=resourcegroups/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups=microsoft.network.infra.azure.com,resources=backendaddresspools;frontendipconfigurations;inboundnatrules;loadbalancers;loadbalancingrules;networkinterfaceipconfigurations;networksecuritygroups;outboundrules;routes;routetables;securityrules;subnets;virtualnetworks,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=microsoft.network.infra.azure.com,resources=backendaddresspools/status;frontendipconfigurations/status;inboundnatrules/status;loadbalancers/status;loadbalancingrules/status;networkinterfaceipconfigurations/status;networksecuritygroups/status;outboundrules/status;routes/status;routetables/status;securityrules/status;subnets/status;virtualnetworks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=microsoft.network.infra.azure.com,resources=backendaddresspools;frontendipconfigurations;inboundnatrules;loadbalancers;loadbalancingrules;networkinterfaces;networkinterfaceipconfigurations;networksecuritygroups;outboundrules;privatednszones;publicipaddresses;routes;routetables;securityrules;subnets;virtualnetworklinks;virtualnetworkpeerings;virtualnetworks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=microsoft.network.infra.azure.com,resources=backendaddresspools/status;frontendipconfigurations/status;inboundnatrules/status;loadbalancers/status;loadbalancingrules/status;networkinterfaces/status;networkinterfaceipconfigurations/status;networksecuritygroups/status;outboundrules/status;privatednszones/status;publicipaddresses/status;routes/status;routetables/status;securityrules/status;subnets/status;virtualnetworklinks/status;virtualnetworkpeerings/status;virtualnetworks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=microsoft.compute.infra.azure.com,resources=disks;virtualmachines,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=microsoft.compute.infra.azure.com,resources=disks/status;virtualmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=microsoft.storage.infra.azure.com,resources=blobcontainers;storageaccounts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=microsoft.storage.infra.azure.com,resources=blobcontainers/status;storageaccounts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=microsoft.keyvault.infra.azure.com,resources=vaults,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=microsoft.keyvault.infra.azure.com,resources=vaults/status,verbs=get;update;patch
 
 type (
 	// GenericReconciler reconciles a Resourcer object
@@ -123,7 +160,7 @@ func register(mgr ctrl.Manager, applier zips.Applier, obj runtime.Object, log lo
 		return err
 	}
 
-	if err := mgr.GetFieldIndexer().IndexField(obj, "status.id", func(obj runtime.Object) []string {
+	if err := mgr.GetFieldIndexer().IndexField(obj, azcorev1.ARMIDIndexKey, func(obj runtime.Object) []string {
 		unObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
 		if err != nil {
 			return []string{}
@@ -134,9 +171,15 @@ func register(mgr ctrl.Manager, applier zips.Applier, obj runtime.Object, log lo
 			return []string{}
 		}
 
-		return []string{id}
+		return []string{strings.ToLower(id)}
 	}); err != nil {
-		return fmt.Errorf("unable to setup field indexer for status.id of %v with: %w", gvk, err)
+		return fmt.Errorf("unable to setup field indexer for %s of %v with: %w", azcorev1.ARMIDIndexKey, gvk, err)
+	}
+
+	if indexed, ok := obj.(azcorev1.FieldIndexed); ok {
+		if err := indexed.IndexFields(mgr.GetFieldIndexer()); err != nil {
+			return fmt.Errorf("unable to setup field indexers of %v with: %w", gvk, err)
+		}
 	}
 
 	reconciler := &GenericReconciler{
@@ -176,6 +219,15 @@ func register(mgr ctrl.Manager, applier zips.Applier, obj runtime.Object, log lo
 				ctrlBuilder.Owns(ownedObj)
 			}
 		}
+
+		if err := watchReferences(mgr, ctrlBuilder, metaObj, gvk, reconciler.Log); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := obj.(azcorev1.OutputsExporter); ok {
+		// outputs are written to ConfigMaps and Secrets owned by the object, so keep them in sync
+		ctrlBuilder.Owns(&v1.ConfigMap{}).Owns(&v1.Secret{})
 	}
 
 	c, err := ctrlBuilder.Build(reconciler)
@@ -190,6 +242,161 @@ func register(mgr ctrl.Manager, applier zips.Applier, obj runtime.Object, log lo
 		Complete()
 }
 
+// watchReferences indexes each non-owned type reference of obj and watches the referenced kinds, so that a change to a
+// referenced object (for example, a resource group reaching a Succeeded provisioning state) will immediately enqueue
+// the objects referring to it rather than waiting for them to requeue.
+func watchReferences(mgr ctrl.Manager, ctrlBuilder *builder.Builder, obj azcorev1.MetaObject, gvk schema.GroupVersionKind, log logr.Logger) error {
+	trls, err := xform.GetAllTypeReferenceData(obj)
+	if err != nil {
+		return fmt.Errorf("unable get all type reference data for obj %v with: %w", obj, err)
+	}
+
+	indexKeysByKind := make(map[schema.GroupVersionKind][]string)
+	for _, trl := range trls {
+		if trl.IsOwned {
+			// owned references are already watched through Owns()
+			continue
+		}
+
+		refGVK := schema.GroupVersionKind{
+			Group:   trl.Group,
+			Version: "v1",
+			Kind:    trl.Kind,
+		}
+
+		if !mgr.GetScheme().Recognizes(refGVK) {
+			// there is no controller for the referenced kind, so there is nothing to watch
+			continue
+		}
+
+		indexKey := referenceIndexKey(trl)
+		if err := mgr.GetFieldIndexer().IndexField(obj, indexKey, referenceIndexFunc(trl)); err != nil {
+			return fmt.Errorf("unable to setup field indexer for %s of %v with: %w", indexKey, gvk, err)
+		}
+
+		indexKeysByKind[refGVK] = append(indexKeysByKind[refGVK], indexKey)
+	}
+
+	listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+	for refGVK, indexKeys := range indexKeysByKind {
+		refObj, err := mgr.GetScheme().New(refGVK)
+		if err != nil {
+			return fmt.Errorf("unable to create GVK %v with: %w", refGVK, err)
+		}
+
+		ctrlBuilder.Watches(&source.Kind{Type: refObj}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: referencingRequestsMapper(mgr.GetClient(), mgr.GetScheme(), listGVK, indexKeys, log),
+		})
+	}
+
+	return nil
+}
+
+// referenceIndexKey is the name of the field index for the type reference location
+func referenceIndexKey(trl xform.TypeReferenceLocation) string {
+	return strings.Join(trl.JSONFields(), ".")
+}
+
+// referenceIndexFunc builds an index function which returns the "namespace/name" of each object referenced at the
+// type reference location. References without a namespace are assumed to be in the namespace of the referring object.
+func referenceIndexFunc(trl xform.TypeReferenceLocation) client.IndexerFunc {
+	return func(obj runtime.Object) []string {
+		metaObj, ok := obj.(metav1.Object)
+		if !ok {
+			return []string{}
+		}
+
+		unObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return []string{}
+		}
+
+		var unRefs []interface{}
+		if trl.IsSlice {
+			refs, ok, err := unstructured.NestedSlice(unObj, trl.JSONFields()...)
+			if err != nil || !ok {
+				return []string{}
+			}
+			unRefs = refs
+		} else {
+			ref, ok, err := unstructured.NestedMap(unObj, trl.JSONFields()...)
+			if err != nil || !ok {
+				return []string{}
+			}
+			unRefs = []interface{}{ref}
+		}
+
+		var keys []string
+		for _, unRef := range unRefs {
+			ref, ok := unRef.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name, _, _ := unstructured.NestedString(ref, "name")
+			if name == "" {
+				continue
+			}
+
+			namespace, _, _ := unstructured.NestedString(ref, "namespace")
+			if namespace == "" {
+				namespace = metaObj.GetNamespace()
+			}
+
+			keys = append(keys, client.ObjectKey{Namespace: namespace, Name: name}.String())
+		}
+
+		return keys
+	}
+}
+
+// referencingRequestsMapper maps a referenced object to reconcile requests for each object of the list kind which
+// refers to it through any of the provided field indexes
+func referencingRequestsMapper(c client.Client, scheme *runtime.Scheme, listGVK schema.GroupVersionKind, indexKeys []string, log logr.Logger) handler.ToRequestsFunc {
+	return func(mo handler.MapObject) []reconcile.Request {
+		ctx := context.Background()
+		refKey := client.ObjectKey{Namespace: mo.Meta.GetNamespace(), Name: mo.Meta.GetName()}.String()
+
+		seen := make(map[client.ObjectKey]bool)
+		var requests []reconcile.Request
+		for _, indexKey := range indexKeys {
+			list, err := scheme.New(listGVK)
+			if err != nil {
+				log.Error(err, "unable to create list object", "gvk", listGVK)
+				return nil
+			}
+
+			if err := c.List(ctx, list, client.MatchingFields{indexKey: refKey}); err != nil {
+				log.Error(err, "unable to list referencing objects", "index", indexKey, "ref", refKey)
+				continue
+			}
+
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				log.Error(err, "unable to extract referencing objects from list", "gvk", listGVK)
+				continue
+			}
+
+			for _, item := range items {
+				itemMeta, err := meta.Accessor(item)
+				if err != nil {
+					continue
+				}
+
+				key := client.ObjectKey{Namespace: itemMeta.GetNamespace(), Name: itemMeta.GetName()}
+				if seen[key] {
+					continue
+				}
+
+				seen[key] = true
+				requests = append(requests, reconcile.Request{NamespacedName: key})
+			}
+		}
+
+		return requests
+	}
+}
+
 // Reconcile will take state in K8s and apply it to Azure
 func (gr *GenericReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
@@ -261,6 +468,21 @@ func (gr *GenericReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		}, nil
 	}
 
+	if _, ok := obj.(azcorev1.Allocator); ok {
+		allocated, err := gr.allocate(ctx, metaObj)
+		if err != nil {
+			log.Error(err, "failed allocating")
+			gr.Recorder.Event(metaObj, v1.EventTypeWarning, "AllocationError", err.Error())
+			return ctrl.Result{}, err
+		}
+
+		if !allocated {
+			return ctrl.Result{
+				RequeueAfter: 5 * time.Second,
+			}, nil
+		}
+	}
+
 	log.Info("reconcile apply start")
 	result, err := gr.reconcileApply(ctx, metaObj, log)
 	if err != nil {
@@ -285,6 +507,35 @@ func (gr *GenericReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	return result, err
 }
 
+// allocate records the allocation an Allocator needs in its status before it is applied to Azure. It returns false if
+// the allocation should be tried again.
+func (gr *GenericReconciler) allocate(ctx context.Context, metaObj azcorev1.MetaObject) (bool, error) {
+	var allocated bool
+	var description string
+	var allocErr error
+	if err := patcher(ctx, gr.Client, metaObj, func(mutMetaObject azcorev1.MetaObject) error {
+		allocator, ok := mutMetaObject.(azcorev1.Allocator)
+		if !ok {
+			return fmt.Errorf("expected an azcorev1.Allocator, but was %T", mutMetaObject)
+		}
+
+		allocated, description, allocErr = allocator.Allocate(ctx, gr.Client)
+		return nil
+	}); err != nil {
+		return false, fmt.Errorf("failed to patch allocation with: %w", err)
+	}
+
+	if allocErr != nil {
+		return false, allocErr
+	}
+
+	if description != "" {
+		gr.Recorder.Event(metaObj, v1.EventTypeNormal, "Allocated", description)
+	}
+
+	return allocated, nil
+}
+
 // reconcileApply will determine what, if anything, has changed on the resource, and apply that state to Azure.
 // The Az infra finalizer will be applied.
 //
@@ -312,10 +563,21 @@ func (gr *GenericReconciler) reconcileApply(ctx context.Context, metaObj azcorev
 	if !hasChanged && zips.IsTerminalProvisioningState(resource.ProvisioningState) {
 		msg := fmt.Sprintf("resource in state %q and spec has not changed", resource.ProvisioningState)
 		gr.Recorder.Event(metaObj, v1.EventTypeNormal, "ResourceHasNotChanged", msg)
-		return ctrl.Result{}, nil
+		return gr.refreshOutputs(ctx, metaObj, resource)
+	}
+
+	recreate, err := needsRecreate(metaObj, resource)
+	if err != nil {
+		err = fmt.Errorf("failed comparing immutable fields hash with: %w", err)
+		gr.Recorder.Event(metaObj, v1.EventTypeWarning, "AnnotationError", err.Error())
+		return ctrl.Result{}, err
 	}
 
 	switch {
+	case hasChanged && recreate:
+		msg := fmt.Sprintf("resource in state %q has changed immutable fields and will be deleted and created again in Azure", resource.ProvisioningState)
+		gr.Recorder.Event(metaObj, v1.EventTypeNormal, "ResourceRecreate", msg)
+		return gr.recreateResource(ctx, metaObj, resource)
 	case hasChanged:
 		msg := fmt.Sprintf("resource in state %q has changed and spec will be applied to Azure", resource.ProvisioningState)
 		gr.Recorder.Event(metaObj, v1.EventTypeNormal, "ResourceHasChanged", msg)
@@ -380,22 +642,60 @@ func (gr *GenericReconciler) reconcileDelete(ctx context.Context, metaObj azcore
 		gr.Recorder.Event(metaObj, v1.EventTypeNormal, "ResourceDeleteInProgress", msg)
 		return gr.updateFromNonTerminalDeleteState(ctx, resource, metaObj)
 	default:
+		if resource.ID != "" && azcorev1.IsDeletionProtected(gr.GVK.GroupKind(), metaObj) {
+			// the validating webhook should have refused the delete, but it may have been bypassed or the object
+			// protected after it was deleted; either way, leave the Azure resource alone
+			return gr.blockDelete(ctx, metaObj)
+		}
+
 		msg := fmt.Sprintf("start deleting resource in state %q", resource.ProvisioningState)
 		gr.Recorder.Event(metaObj, v1.EventTypeNormal, "ResourceDeleteStart", msg)
 		return gr.startDeleteOfResource(ctx, resource, metaObj)
 	}
 }
 
+// blockDelete records that the delete of a protected resource will not happen until the protection is removed. The
+// change of annotation will trigger another reconcile, so there is no need to requeue.
+func (gr *GenericReconciler) blockDelete(ctx context.Context, metaObj azcorev1.MetaObject) (ctrl.Result, error) {
+	blockedErr := azcorev1.DeletionProtectedError(gr.GVK.GroupKind(), metaObj)
+	if err := patcher(ctx, gr.Client, metaObj, func(mutMetaObject azcorev1.MetaObject) error {
+		if conditioned, ok := mutMetaObject.(azcorev1.Conditioned); ok {
+			conditioned.SetConditions(conditioned.GetConditions().Set(azcorev1.Condition{
+				Type:    azcorev1.DeletionBlockedCondition,
+				Status:  v1.ConditionTrue,
+				Reason:  "DeletionProtected",
+				Message: blockedErr.Error(),
+			}))
+		}
+		return nil
+	}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to patch after blocking delete with: %w", err)
+	}
+
+	gr.Recorder.Event(metaObj, v1.EventTypeWarning, "DeletionBlocked", blockedErr.Error())
+	return ctrl.Result{}, nil
+}
+
 // startDeleteOfResource will begin the delete of a resource by telling Azure to start deleting it. The resource will be
 // marked with the provisioning state of "Deleting".
 func (gr *GenericReconciler) startDeleteOfResource(ctx context.Context, resource *zips.Resource, metaObj azcorev1.MetaObject) (ctrl.Result, error) {
+	var conflictErr error
 	if err := patcher(ctx, gr.Client, metaObj, func(mutMetaObject azcorev1.MetaObject) error {
 		if resource.ID != "" {
-			if _, err := gr.Applier.BeginDelete(ctx, resource); err != nil {
+			if _, err := gr.withConflictRetry(ctx, mutMetaObject, resource, gr.Applier.BeginDelete); err != nil {
+				if zips.IsPreconditionFailed(err) {
+					conflictErr = err
+					setConflictCondition(mutMetaObject, err)
+					return nil
+				}
 				return fmt.Errorf("failed trying to delete with %w", err)
 			}
 
 			resource.ProvisioningState = zips.DeletingProvisioningState
+			removeConflictCondition(mutMetaObject)
+			if conditioned, ok := mutMetaObject.(azcorev1.Conditioned); ok {
+				conditioned.SetConditions(conditioned.GetConditions().Remove(azcorev1.DeletionBlockedCondition))
+			}
 		} else {
 			controllerutil.RemoveFinalizer(mutMetaObject, apis.AzureInfraFinalizer)
 		}
@@ -409,6 +709,12 @@ func (gr *GenericReconciler) startDeleteOfResource(ctx context.Context, resource
 		return ctrl.Result{}, fmt.Errorf("failed to patch after starting delete with: %w", err)
 	}
 
+	if conflictErr != nil {
+		// wait for the object to be changed, eg. opting in to retry on conflict, rather than repeatedly failing
+		gr.Recorder.Event(metaObj, v1.EventTypeWarning, "Conflict", conflictErr.Error())
+		return ctrl.Result{}, nil
+	}
+
 	// delete has started, check back to seen when the finalizer can be removed
 	return ctrl.Result{
 		RequeueAfter: 5 * time.Second,
@@ -475,7 +781,11 @@ func (gr *GenericReconciler) updateFromNonTerminalApplyState(ctx context.Context
 			RequeueAfter: 20 * time.Second,
 		}
 	}
-	return result, err
+
+	if err := gr.reconcileOutputs(ctx, metaObj, resource); err != nil {
+		return result, err
+	}
+	return result, nil
 }
 
 // applySpecChange will apply the new spec state to an Azure resource. The resource should then enter
@@ -486,14 +796,24 @@ func (gr *GenericReconciler) applySpecChange(ctx context.Context, metaObj azcore
 		return ctrl.Result{}, fmt.Errorf("unable to transform to resource with: %w", err)
 	}
 
+	var conflictErr error
 	if err := patcher(ctx, gr.Client, metaObj, func(mutObj azcorev1.MetaObject) error {
 		controllerutil.AddFinalizer(mutObj, apis.AzureInfraFinalizer)
 		resource.ProvisioningState = ""
-		resource, err = gr.Applier.Apply(ctx, resource)
+		applied, err := gr.withConflictRetry(ctx, mutObj, resource, gr.Applier.Apply)
+		if zips.IsPreconditionFailed(err) {
+			// leave the resource hash as is, so the spec will be applied once the conflict is resolved
+			conflictErr = err
+			setConflictCondition(mutObj, err)
+			return nil
+		}
+
 		if err != nil {
 			return fmt.Errorf("failed to apply state to Azure with %w", err)
 		}
 
+		resource = applied
+		removeConflictCondition(mutObj)
 		if err := gr.Converter.FromResource(resource, mutObj); err != nil {
 			return err
 		}
@@ -507,13 +827,367 @@ func (gr *GenericReconciler) applySpecChange(ctx context.Context, metaObj azcore
 		return ctrl.Result{}, fmt.Errorf("failed to patch with: %w", err)
 	}
 
+	if conflictErr != nil {
+		gr.Recorder.Event(metaObj, v1.EventTypeWarning, "Conflict", conflictErr.Error())
+		return ctrl.Result{}, nil
+	}
+
 	result := ctrl.Result{}
 	if !zips.IsTerminalProvisioningState(resource.ProvisioningState) {
 		result = ctrl.Result{
 			RequeueAfter: 5 * time.Second,
 		}
 	}
-	return result, err
+
+	if err := gr.reconcileOutputs(ctx, metaObj, resource); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// recreateResource deletes the Azure resource so it can be created again with the changed immutable fields. Once the
+// resource is gone from Azure, the status is reset and the new spec is applied as if the resource were new.
+func (gr *GenericReconciler) recreateResource(ctx context.Context, metaObj azcorev1.MetaObject, resource *zips.Resource) (ctrl.Result, error) {
+	if resource.ProvisioningState != zips.DeletingProvisioningState {
+		if azcorev1.IsDeletionProtected(gr.GVK.GroupKind(), metaObj) {
+			// recreating starts by deleting the Azure resource, which the protection forbids
+			return gr.blockDelete(ctx, metaObj)
+		}
+
+		var conflictErr error
+		if err := patcher(ctx, gr.Client, metaObj, func(mutObj azcorev1.MetaObject) error {
+			if _, err := gr.withConflictRetry(ctx, mutObj, resource, gr.Applier.BeginDelete); err != nil {
+				if zips.IsPreconditionFailed(err) {
+					conflictErr = err
+					setConflictCondition(mutObj, err)
+					return nil
+				}
+				return fmt.Errorf("failed trying to delete with %w", err)
+			}
+
+			resource.ProvisioningState = zips.DeletingProvisioningState
+			removeConflictCondition(mutObj)
+			if conditioned, ok := mutObj.(azcorev1.Conditioned); ok {
+				conditioned.SetConditions(conditioned.GetConditions().Remove(azcorev1.DeletionBlockedCondition))
+			}
+			return gr.Converter.FromResource(resource, mutObj)
+		}); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch after starting delete with: %w", err)
+		}
+
+		if conflictErr != nil {
+			// wait for the object to be changed, eg. opting in to retry on conflict, rather than repeatedly failing
+			gr.Recorder.Event(metaObj, v1.EventTypeWarning, "Conflict", conflictErr.Error())
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{
+			RequeueAfter: 5 * time.Second,
+		}, nil
+	}
+
+	found, err := gr.Applier.HeadResource(ctx, resource)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to head resource with: %w", err)
+	}
+
+	if found {
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	if err := patcher(ctx, gr.Client, metaObj, func(mutObj azcorev1.MetaObject) error {
+		// forget the deleted resource, so the spec will be applied as a new resource
+		return gr.Converter.FromResource(new(zips.Resource), mutObj)
+	}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to patch with: %w", err)
+	}
+
+	return gr.applySpecChange(ctx, metaObj)
+}
+
+// withConflictRetry calls op with the resource. If Azure rejects op because the resource has changed since it was last
+// read and the object opted in with the retry on conflict annotation, the latest ETag is read from Azure and op is
+// called once more, overwriting the changes made outside of the operator.
+func (gr *GenericReconciler) withConflictRetry(ctx context.Context, metaObj azcorev1.MetaObject, resource *zips.Resource, op func(context.Context, *zips.Resource) (*zips.Resource, error)) (*zips.Resource, error) {
+	res, err := op(ctx, resource)
+	if !zips.IsPreconditionFailed(err) || !resource.ObjectMeta.RetryOnConflict {
+		return res, err
+	}
+
+	latest, getErr := gr.Applier.GetResource(ctx, &zips.Resource{
+		ID:         resource.ID,
+		APIVersion: resource.APIVersion,
+	})
+	if getErr != nil {
+		return res, fmt.Errorf("failed to read the latest ETag after a conflict with: %w", getErr)
+	}
+
+	msg := fmt.Sprintf("resource was changed in Azure; retrying with the latest ETag %s", latest.ETag)
+	gr.Recorder.Event(metaObj, v1.EventTypeNormal, "ConflictRetry", msg)
+	resource.ETag = latest.ETag
+	return op(ctx, resource)
+}
+
+// refreshOutputs writes the outputs of an unchanged resource from its latest state in Azure, so changes made on the
+// Azure side reach the ConfigMap and Secret, and removes the outputs the outputs spec no longer names. The resource is
+// only read from Azure if it has outputs to evaluate or its Secret is missing, and the secrets of the object are only
+// exported again if the Secret is missing. The ETag of the object is left as it was last applied, so a change made
+// outside of the operator is reported as a conflict rather than silently adopted. Objects with outputs to evaluate are
+// requeued so their outputs are refreshed periodically.
+func (gr *GenericReconciler) refreshOutputs(ctx context.Context, metaObj azcorev1.MetaObject, resource *zips.Resource) (ctrl.Result, error) {
+	exporter, ok := metaObj.(azcorev1.OutputsExporter)
+	if !ok || resource.ProvisioningState != zips.SucceededProvisioningState {
+		return ctrl.Result{}, nil
+	}
+
+	outputsSpec := exporter.GetOutputs()
+	if err := gr.removeUnnamedOutputs(ctx, metaObj, outputsSpec); err != nil {
+		gr.Recorder.Event(metaObj, v1.EventTypeWarning, "OutputsError", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if outputsSpec == nil {
+		return ctrl.Result{}, nil
+	}
+
+	_, exportsSecrets := metaObj.(azcorev1.SecretsExporter)
+	secretMissing := false
+	if exportsSecrets && outputsSpec.SecretName != "" {
+		var err error
+		if secretMissing, err = gr.isSecretMissing(ctx, metaObj, outputsSpec.SecretName); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if len(outputsSpec.Values) == 0 && !secretMissing {
+		return ctrl.Result{}, nil
+	}
+
+	latest, err := gr.Applier.GetResource(ctx, &zips.Resource{
+		ID:         resource.ID,
+		APIVersion: resource.APIVersion,
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to get resource for outputs with: %w", err)
+		gr.Recorder.Event(metaObj, v1.EventTypeWarning, "OutputsError", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if resource.ETag != "" && latest.ETag != resource.ETag {
+		conflictErr := fmt.Errorf("the ETag in Azure is %s, but %s was last applied", latest.ETag, resource.ETag)
+		if err := patcher(ctx, gr.Client, metaObj, func(mutObj azcorev1.MetaObject) error {
+			setConflictCondition(mutObj, conflictErr)
+			return nil
+		}); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch with: %w", err)
+		}
+
+		gr.Recorder.Event(metaObj, v1.EventTypeWarning, "Conflict", conflictErr.Error())
+	}
+
+	// provisioning state is tracked by the operator rather than read from the resource
+	latest.ProvisioningState = resource.ProvisioningState
+	if err := gr.writeOutputs(ctx, metaObj, latest, outputsSpec, secretMissing); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if len(outputsSpec.Values) == 0 {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: outputsRefreshInterval}, nil
+}
+
+// isSecretMissing returns true if the Secret of the given name does not exist in the namespace of the object
+func (gr *GenericReconciler) isSecretMissing(ctx context.Context, metaObj azcorev1.MetaObject, name string) (bool, error) {
+	key := client.ObjectKey{
+		Namespace: metaObj.GetNamespace(),
+		Name:      name,
+	}
+
+	if err := gr.Client.Get(ctx, key, &v1.Secret{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get outputs %s with: %w", key, err)
+	}
+	return false, nil
+}
+
+// reconcileOutputs writes the outputs of a Succeeded resource to the ConfigMap and / or Secret named in the outputs
+// spec of the object, along with any secrets the object exports to the Secret. The ConfigMap and Secret are owned by
+// the object, so they will be deleted along with it, or as soon as the outputs spec no longer names them.
+func (gr *GenericReconciler) reconcileOutputs(ctx context.Context, metaObj azcorev1.MetaObject, resource *zips.Resource) error {
+	exporter, ok := metaObj.(azcorev1.OutputsExporter)
+	if !ok {
+		return nil
+	}
+
+	outputsSpec := exporter.GetOutputs()
+	if err := gr.removeUnnamedOutputs(ctx, metaObj, outputsSpec); err != nil {
+		gr.Recorder.Event(metaObj, v1.EventTypeWarning, "OutputsError", err.Error())
+		return err
+	}
+
+	return gr.writeOutputs(ctx, metaObj, resource, outputsSpec, true)
+}
+
+// writeOutputs writes the outputs of a Succeeded resource to the ConfigMap and / or Secret named in the given outputs
+// spec. The secrets of the object are exported to the Secret if exportSecrets is true, otherwise the secrets already
+// in the Secret are kept.
+func (gr *GenericReconciler) writeOutputs(ctx context.Context, metaObj azcorev1.MetaObject, resource *zips.Resource, outputsSpec *azcorev1.OutputsSpec, exportSecrets bool) error {
+	secretsExporter, exportsSecrets := metaObj.(azcorev1.SecretsExporter)
+	exportsSecrets = exportsSecrets && outputsSpec != nil && outputsSpec.SecretName != ""
+	if outputsSpec == nil || (len(outputsSpec.Values) == 0 && !exportsSecrets) || resource.ProvisioningState != zips.SucceededProvisioningState {
+		return nil
+	}
+
+	outputs, err := xform.ResourceOutputs(resource, outputsSpec.Values)
+	if err != nil {
+		gr.Recorder.Event(metaObj, v1.EventTypeWarning, "OutputsError", err.Error())
+		return fmt.Errorf("failed to evaluate outputs with: %w", err)
+	}
+
+	if outputsSpec.ConfigMapName != "" {
+		cm := &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      outputsSpec.ConfigMapName,
+				Namespace: metaObj.GetNamespace(),
+			},
+		}
+
+		if _, err := controllerutil.CreateOrUpdate(ctx, gr.Client, cm, func() error {
+			cm.Data = outputs
+			setOutputsOwnerLabel(cm, metaObj)
+			return controllerutil.SetControllerReference(metaObj, cm, gr.Scheme)
+		}); err != nil {
+			gr.Recorder.Event(metaObj, v1.EventTypeWarning, "OutputsError", err.Error())
+			return fmt.Errorf("failed to write outputs to config map %q with: %w", outputsSpec.ConfigMapName, err)
+		}
+	}
+
+	if outputsSpec.SecretName != "" {
+		secretValues := outputs
+		// only the outputs are replaced unless the secrets are exported again
+		keepSecrets := exportsSecrets && !exportSecrets
+		if exportsSecrets && exportSecrets {
+			secrets, err := secretsExporter.ExportSecrets(ctx, func(ctx context.Context, action string) (json.RawMessage, error) {
+				return gr.Applier.InvokeAction(ctx, resource, action)
+			})
+			if err != nil {
+				gr.Recorder.Event(metaObj, v1.EventTypeWarning, "OutputsError", err.Error())
+				return fmt.Errorf("failed to export secrets with: %w", err)
+			}
+
+			secretValues = make(map[string]string, len(outputs)+len(secrets))
+			for key, value := range secrets {
+				secretValues[key] = value
+			}
+			// outputs are named explicitly in the spec, so they take precedence over the exported secrets
+			for key, value := range outputs {
+				secretValues[key] = value
+			}
+		}
+
+		secret := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      outputsSpec.SecretName,
+				Namespace: metaObj.GetNamespace(),
+			},
+		}
+
+		if _, err := controllerutil.CreateOrUpdate(ctx, gr.Client, secret, func() error {
+			if !keepSecrets || secret.Data == nil {
+				secret.Data = make(map[string][]byte, len(secretValues))
+			}
+			for key, value := range secretValues {
+				secret.Data[key] = []byte(value)
+			}
+			setOutputsOwnerLabel(secret, metaObj)
+			return controllerutil.SetControllerReference(metaObj, secret, gr.Scheme)
+		}); err != nil {
+			gr.Recorder.Event(metaObj, v1.EventTypeWarning, "OutputsError", err.Error())
+			return fmt.Errorf("failed to write outputs to secret %q with: %w", outputsSpec.SecretName, err)
+		}
+	}
+
+	return nil
+}
+
+// removeUnnamedOutputs deletes the ConfigMaps and Secrets controlled by the object which the outputs spec no longer
+// names, so renaming or removing the outputs does not leave the old ones behind until the object is deleted. Only the
+// ConfigMaps and Secrets labeled with the UID of the object are listed.
+func (gr *GenericReconciler) removeUnnamedOutputs(ctx context.Context, metaObj azcorev1.MetaObject, outputsSpec *azcorev1.OutputsSpec) error {
+	var configMapName, secretName string
+	if outputsSpec != nil {
+		configMapName, secretName = outputsSpec.ConfigMapName, outputsSpec.SecretName
+	}
+
+	ownedOutputs := client.MatchingLabels{OutputsOwnerLabelKey: string(metaObj.GetUID())}
+	var configMaps v1.ConfigMapList
+	if err := gr.Client.List(ctx, &configMaps, client.InNamespace(metaObj.GetNamespace()), ownedOutputs); err != nil {
+		return fmt.Errorf("failed to list config maps with: %w", err)
+	}
+
+	for i := range configMaps.Items {
+		cm := &configMaps.Items[i]
+		if cm.Name == configMapName || !metav1.IsControlledBy(cm, metaObj) {
+			continue
+		}
+
+		if err := gr.Client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete outputs config map %q with: %w", cm.Name, err)
+		}
+	}
+
+	var secrets v1.SecretList
+	if err := gr.Client.List(ctx, &secrets, client.InNamespace(metaObj.GetNamespace()), ownedOutputs); err != nil {
+		return fmt.Errorf("failed to list secrets with: %w", err)
+	}
+
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secret.Name == secretName || !metav1.IsControlledBy(secret, metaObj) {
+			continue
+		}
+
+		if err := gr.Client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete outputs secret %q with: %w", secret.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// setOutputsOwnerLabel labels the ConfigMap or Secret outputs are written to with the UID of the object, so the
+// outputs of the object can be listed without listing the whole namespace
+func setOutputsOwnerLabel(output metav1.Object, owner azcorev1.MetaObject) {
+	labels := output.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	labels[OutputsOwnerLabelKey] = string(owner.GetUID())
+	output.SetLabels(labels)
+}
+
+func setConflictCondition(metaObj azcorev1.MetaObject, err error) {
+	conditioned, ok := metaObj.(azcorev1.Conditioned)
+	if !ok {
+		return
+	}
+
+	conditioned.SetConditions(conditioned.GetConditions().Set(azcorev1.Condition{
+		Type:    azcorev1.ConflictCondition,
+		Status:  v1.ConditionTrue,
+		Reason:  "ETagMismatch",
+		Message: fmt.Sprintf("resource was changed in Azure since it was last read; set the %q annotation to \"true\" to overwrite the changes: %s", zips.RetryOnConflictAnnotation, err),
+	}))
+}
+
+func removeConflictCondition(metaObj azcorev1.MetaObject) {
+	if conditioned, ok := metaObj.(azcorev1.Conditioned); ok {
+		conditioned.SetConditions(conditioned.GetConditions().Remove(azcorev1.ConflictCondition))
+	}
 }
 
 func hasResourceHashAnnotationChanged(metaObj azcorev1.MetaObject) (bool, error) {
@@ -531,18 +1205,39 @@ func hasResourceHashAnnotationChanged(metaObj azcorev1.MetaObject) (bool, error)
 	return oldSig != newSig, nil
 }
 
+// needsRecreate returns true if the immutable fields of an existing resource have changed since the spec was last
+// applied and the object allows the resource to be recreated
+func needsRecreate(metaObj azcorev1.MetaObject, resource *zips.Resource) (bool, error) {
+	oldSig, exists := metaObj.GetAnnotations()[ImmutableSigAnnotationKey]
+	if !exists || resource.ID == "" || !azcorev1.IsRecreateAllowed(metaObj) {
+		return false, nil
+	}
+
+	newSig, err := azcorev1.ImmutableFieldsSignature(metaObj)
+	if err != nil {
+		return false, err
+	}
+	return oldSig != newSig, nil
+}
+
 func addResourceHashAnnotation(metaObj azcorev1.MetaObject) error {
 	sig, err := azcorev1.SpecSignature(metaObj)
 	if err != nil {
 		return err
 	}
 
+	immutableSig, err := azcorev1.ImmutableFieldsSignature(metaObj)
+	if err != nil {
+		return err
+	}
+
 	annotations := metaObj.GetAnnotations()
 	if annotations == nil {
 		annotations = map[string]string{}
 	}
 
 	annotations[ResourceSigAnnotationKey] = sig
+	annotations[ImmutableSigAnnotationKey] = immutableSig
 	metaObj.SetAnnotations(annotations)
 	return nil
 }
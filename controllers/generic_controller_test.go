@@ -62,6 +62,11 @@ func (am *ApplierMock) HeadResource(ctx context.Context, res *zips.Resource) (bo
 	return args.Bool(0), args.Error(1)
 }
 
+func (am *ApplierMock) InvokeAction(ctx context.Context, res *zips.Resource, action string) (json.RawMessage, error) {
+	args := am.Called(ctx, res, action)
+	return args.Get(0).(json.RawMessage), args.Error(1)
+}
+
 var _ = Describe("GenericReconciler", func() {
 	BeforeEach(func() {})
 	AfterEach(func() {})
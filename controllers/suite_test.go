@@ -23,8 +23,11 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	// +kubebuilder:scaffold:imports
 
+	microsoftcomputev1 "github.com/Azure/k8s-infra/apis/microsoft.compute/v1"
+	microsoftkeyvaultv1 "github.com/Azure/k8s-infra/apis/microsoft.keyvault/v1"
 	microsoftnetworkv1 "github.com/Azure/k8s-infra/apis/microsoft.network/v1"
 	microsoftresourcesv1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
+	microsoftstoragev1 "github.com/Azure/k8s-infra/apis/microsoft.storage/v1"
 )
 
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
@@ -65,6 +68,9 @@ var _ = BeforeSuite(func(done Done) {
 
 	Expect(microsoftresourcesv1.AddToScheme(scheme.Scheme)).NotTo(HaveOccurred())
 	Expect(microsoftnetworkv1.AddToScheme(scheme.Scheme)).NotTo(HaveOccurred())
+	Expect(microsoftcomputev1.AddToScheme(scheme.Scheme)).NotTo(HaveOccurred())
+	Expect(microsoftstoragev1.AddToScheme(scheme.Scheme)).NotTo(HaveOccurred())
+	Expect(microsoftkeyvaultv1.AddToScheme(scheme.Scheme)).NotTo(HaveOccurred())
 	mgr, err = ctrl.NewManager(cfg, ctrl.Options{Scheme: scheme.Scheme})
 	Expect(err).NotTo(HaveOccurred())
 
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package gen
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Azure/k8s-infra/hack/generator/pkg/armgen"
+	"github.com/Azure/k8s-infra/hack/generator/pkg/xcobra"
+)
+
+// NewARMCommand creates a new cobra Command which generates the ARM conversions of API packages from their struct tags
+func NewARMCommand() (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:   "arm [package directory]...",
+		Short: "generate the ToARM and FromARM conversions of the types of API packages from their struct tags",
+		Args:  cobra.MinimumNArgs(1),
+		Run: xcobra.RunWithCtx(func(ctx context.Context, cmd *cobra.Command, args []string) error {
+			for _, dir := range args {
+				log.Printf("Generating %s", filepath.Join(dir, armgen.FileName))
+				if err := armgen.GenerateFile(dir); err != nil {
+					log.Printf("Failed to generate ARM conversions: %v\n", err)
+					return err
+				}
+			}
+
+			return nil
+		}),
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/Azure/k8s-infra/hack/generator/pkg/astmodel"
+)
+
+// diffPackages compares the files the given packages would generate with those already in the output directory,
+// writing a unified diff of every file which would be added, changed or removed to out. Hand-written files are left
+// alone when generating, so are only compared if clean is set. Returns the number of files which differ.
+func diffPackages(
+	packages map[astmodel.PackageReference]*astmodel.PackageDefinition,
+	rootOutputDir string,
+	clean bool,
+	out io.Writer) (int, error) {
+
+	generated := make(map[string][]byte)
+	for _, pkg := range packages {
+		files, err := pkg.RenderDefinitions()
+		if err != nil {
+			return 0, err
+		}
+
+		for fileName, content := range files {
+			generated[filepath.Join(rootOutputDir, pkg.PackagePath(), fileName)] = content
+		}
+	}
+
+	existing, err := findOwnedFiles(rootOutputDir, clean)
+	if err != nil {
+		return 0, err
+	}
+
+	var paths []string
+	for path := range generated {
+		paths = append(paths, path)
+	}
+
+	for path := range existing {
+		if _, ok := generated[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+
+	var added, changed, removed int
+	for _, path := range paths {
+		content, isGenerated := generated[path]
+		current, exists := existing[path]
+
+		if !exists {
+			if _, err := os.Stat(path); err == nil {
+				// a hand-written file, which generating leaves unchanged
+				continue
+			}
+		}
+
+		if exists && isGenerated && bytes.Equal(current, content) {
+			continue
+		}
+
+		switch {
+		case !exists:
+			added++
+		case !isGenerated:
+			removed++
+		default:
+			changed++
+		}
+
+		err := writeDiff(out, path, exists, current, isGenerated, content)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	log.Printf("%d files would be added, %d changed and %d removed\n", added, changed, removed)
+	return added + changed + removed, nil
+}
+
+// findOwnedFiles returns the content of the files in the given directory which generating may replace or remove,
+// keyed by path: the Go files we generated, as removeGeneratedFiles removes, or every file if clean is set
+func findOwnedFiles(dir string, clean bool) (map[string][]byte, error) {
+	result := make(map[string][]byte)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		if !clean {
+			if filepath.Ext(path) != ".go" {
+				return nil
+			}
+
+			generated, err := astmodel.IsGeneratedFile(path)
+			if err != nil || !generated {
+				return err
+			}
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		result[path] = content
+		return nil
+	})
+
+	if os.IsNotExist(err) {
+		return result, nil
+	}
+
+	return result, err
+}
+
+// writeDiff writes a unified diff from the current content of a file to its new content; a file which does not exist
+// on one side of the diff is shown as /dev/null, as git does
+func writeDiff(out io.Writer, path string, exists bool, current []byte, isGenerated bool, content []byte) error {
+	diff := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(content)),
+		FromFile: "a/" + filepath.ToSlash(path),
+		ToFile:   "b/" + filepath.ToSlash(path),
+		Context:  3,
+	}
+
+	if !exists {
+		diff.A = nil
+		diff.FromFile = "/dev/null"
+	}
+
+	if !isGenerated {
+		diff.B = nil
+		diff.ToFile = "/dev/null"
+	}
+
+	if err := difflib.WriteUnifiedDiff(out, diff); err != nil {
+		return fmt.Errorf("writing diff of %s with: %w", path, err)
+	}
+
+	return nil
+}
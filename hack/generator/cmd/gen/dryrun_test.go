@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/Azure/k8s-infra/hack/generator/pkg/astmodel"
+)
+
+func widgetPackages(fields ...*astmodel.FieldDefinition) map[astmodel.PackageReference]*astmodel.PackageDefinition {
+	ref := astmodel.NewStructReference("Widget", "microsoft.test", "v20200101", true)
+	pkg := astmodel.NewPackageDefinition(ref.PackageReference)
+	pkg.AddDefinition(astmodel.NewStructDefinition(ref, fields...))
+	return map[astmodel.PackageReference]*astmodel.PackageDefinition{ref.PackageReference: pkg}
+}
+
+func emitPackages(g *GomegaWithT, packages map[astmodel.PackageReference]*astmodel.PackageDefinition, dir string) {
+	for _, pkg := range packages {
+		outputDir := filepath.Join(dir, pkg.PackagePath())
+		g.Expect(os.MkdirAll(outputDir, 0700)).To(Succeed())
+		g.Expect(pkg.EmitDefinitions(outputDir)).To(Succeed())
+	}
+}
+
+func Test_DiffPackages_GivenEmptyOutputDirectory_ReportsFilesAdded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "dryrun")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	var out bytes.Buffer
+	differences, err := diffPackages(widgetPackages(), filepath.Join(dir, "apis"), false, &out)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(differences).To(Equal(2))
+	g.Expect(out.String()).To(ContainSubstring("--- /dev/null"))
+	g.Expect(out.String()).To(ContainSubstring("+// Code generated by k8s-infra-gen. DO NOT EDIT."))
+
+	// nothing is written
+	g.Expect(filepath.Join(dir, "apis")).NotTo(BeADirectory())
+}
+
+func Test_DiffPackages_GivenUnchangedOutput_ReportsNoDifferences(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "dryrun")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	packages := widgetPackages()
+	emitPackages(g, packages, dir)
+
+	var out bytes.Buffer
+	differences, err := diffPackages(packages, dir, false, &out)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(differences).To(Equal(0))
+	g.Expect(out.String()).To(BeEmpty())
+}
+
+func Test_DiffPackages_GivenChangedDefinition_ReportsChangedLines(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "dryrun")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	emitPackages(g, widgetPackages(), dir)
+
+	var out bytes.Buffer
+	size := astmodel.NewFieldDefinition("Size", "size", astmodel.IntType)
+	differences, err := diffPackages(widgetPackages(size), dir, false, &out)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(differences).To(Equal(1))
+	g.Expect(out.String()).To(ContainSubstring("+++ b/" + filepath.ToSlash(filepath.Join(dir, "microsoft.test", "v20200101", "Widget_types.go"))))
+	g.Expect(out.String()).To(MatchRegexp(`\n\+\s+Size\s+int\s+`))
+}
+
+func Test_DiffPackages_GivenStaleAndHandWrittenFiles_ReportsOnlyStaleFileRemoved(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "dryrun")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	packages := widgetPackages()
+	emitPackages(g, packages, dir)
+
+	outputDir := filepath.Join(dir, "microsoft.test", "v20200101")
+	stale, err := ioutil.ReadFile(filepath.Join(outputDir, "Widget_types.go"))
+	g.Expect(err).To(BeNil())
+	g.Expect(ioutil.WriteFile(filepath.Join(outputDir, "Gadget_types.go"), stale, 0600)).To(Succeed())
+	g.Expect(ioutil.WriteFile(filepath.Join(outputDir, "webhook.go"), []byte("package v20200101\n"), 0600)).To(Succeed())
+
+	var out bytes.Buffer
+	differences, err := diffPackages(packages, dir, false, &out)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(differences).To(Equal(1))
+	g.Expect(out.String()).To(ContainSubstring("Gadget_types.go"))
+	g.Expect(out.String()).NotTo(ContainSubstring("webhook.go"))
+
+	// when cleaning, hand-written files are removed too
+	out.Reset()
+	differences, err = diffPackages(packages, dir, true, &out)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(differences).To(Equal(2))
+	g.Expect(out.String()).To(ContainSubstring("webhook.go"))
+}
+
+func Test_DiffPackages_GivenNonGoFileWithLongLine_IgnoresIt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "dryrun")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	packages := widgetPackages()
+	emitPackages(g, packages, dir)
+
+	// longer than a bufio.Scanner will read as a single line
+	outputDir := filepath.Join(dir, "microsoft.test", "v20200101")
+	g.Expect(ioutil.WriteFile(filepath.Join(outputDir, "widget.bin"), bytes.Repeat([]byte("x"), 100*1024), 0600)).To(Succeed())
+
+	var out bytes.Buffer
+	differences, err := diffPackages(packages, dir, false, &out)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(differences).To(Equal(0))
+	g.Expect(out.String()).To(BeEmpty())
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package gen
+
+import (
+	"context"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Azure/k8s-infra/hack/generator/pkg/schemacache"
+	"github.com/Azure/k8s-infra/hack/generator/pkg/xcobra"
+)
+
+// defaultSchemaCache is the directory fetch downloads the schemas into, and gen loads them from if it exists
+const defaultSchemaCache = "schemas"
+
+// NewFetchCommand creates a new cobra Command which downloads the schemas used by gen into a local cache
+func NewFetchCommand() (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:   "fetch",
+		Short: "download Azure deployment template schema into a local cache, so gen can run without network access",
+		Run: xcobra.RunWithCtx(func(ctx context.Context, cmd *cobra.Command, args []string) error {
+			configurationFile, err := cmd.Flags().GetString("export-config")
+			if err != nil {
+				return err
+			}
+
+			configuration, err := loadConfiguration(configurationFile)
+			if err != nil {
+				log.Printf("Error loading configuration: %v\n", err)
+				return err
+			}
+
+			err = configuration.Validate()
+			if err != nil {
+				log.Printf("Configuration invalid: %v\n", err)
+				return err
+			}
+
+			schemaCache, err := cmd.Flags().GetString("schema-cache")
+			if err != nil {
+				return err
+			}
+
+			log.Printf("Fetching schema %s into %s", configuration.SchemaURL, schemaCache)
+			err = schemacache.NewCache(schemaCache).Fetch(ctx, configuration.SchemaURL)
+			if err != nil {
+				log.Printf("Failed to fetch schema: %v\n", err)
+				return err
+			}
+
+			return nil
+		}),
+	}
+
+	cmd.Flags().String("schema-cache", defaultSchemaCache, "directory to download the schemas into")
+	cmd.Flags().String("export-config", "azure-cloud.yaml", "configuration file selecting the schema to download")
+
+	return cmd, nil
+}
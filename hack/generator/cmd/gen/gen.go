@@ -7,6 +7,7 @@ package gen
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/Azure/k8s-infra/hack/generator/pkg/astmodel"
 	"github.com/Azure/k8s-infra/hack/generator/pkg/jsonast"
+	"github.com/Azure/k8s-infra/hack/generator/pkg/schemacache"
 	"github.com/Azure/k8s-infra/hack/generator/pkg/xcobra"
 )
 
@@ -30,7 +32,12 @@ func NewGenCommand() (*cobra.Command, error) {
 		Run: xcobra.RunWithCtx(func(ctx context.Context, cmd *cobra.Command, args []string) error {
 
 			//TODO extract into a new type (maybe 'Generator') so that this file can concentrate on command line processing
-			configuration, err := loadConfiguration("azure-cloud.yaml")
+			configurationFile, err := cmd.Flags().GetString("export-config")
+			if err != nil {
+				return err
+			}
+
+			configuration, err := loadConfiguration(configurationFile)
 			if err != nil {
 				log.Printf("Error loading configuration: %v\n", err)
 				writeSampleConfig("public-cloud-sample.yaml")
@@ -43,8 +50,18 @@ func NewGenCommand() (*cobra.Command, error) {
 				return err
 			}
 
+			schemaCache, err := cmd.Flags().GetString("schema-cache")
+			if err != nil {
+				return err
+			}
+
+			offline, err := cmd.Flags().GetBool("offline")
+			if err != nil {
+				return err
+			}
+
 			log.Printf("Loading schema %s", configuration.SchemaURL)
-			schema, err := loadSchema(configuration.SchemaURL)
+			schema, err := loadSchema(configuration.SchemaURL, schemaCache, offline)
 			if err != nil {
 				log.Printf("Failed to load schema: %v\n", err)
 				return err
@@ -52,34 +69,35 @@ func NewGenCommand() (*cobra.Command, error) {
 
 			root := schema.Root()
 
-			rootOutputDir := "apis" // TODO: command-line argument
-
-			idfactory := astmodel.NewIdentifierFactory()
-			scanner := jsonast.NewSchemaScanner(idfactory)
-			scanner.AddFilters(viper.GetStringSlice("resources"))
+			rootOutputDir, err := setting(cmd, "output", configuration.OutputPath, "apis")
+			if err != nil {
+				return err
+			}
 
-			_, err = scanner.ToNodes(ctx, root)
+			packageRoot, err := setting(cmd, "package-root", configuration.PackageRoot, astmodel.DefaultGeneratedPackagesRoot)
 			if err != nil {
-				log.Printf("Error: %v\n", err)
 				return err
 			}
 
-			err = os.RemoveAll(rootOutputDir)
+			astmodel.SetGeneratedPackagesRoot(packageRoot)
+
+			idfactory := astmodel.NewIdentifierFactory()
+			scanner := jsonast.NewSchemaScanner(idfactory)
+			err = scanner.AddResourceFilters(viper.GetStringSlice("resources"))
 			if err != nil {
 				log.Printf("Error: %v\n", err)
 				return err
 			}
 
-			err = os.Mkdir(rootOutputDir, 0700)
+			_, err = scanner.ToNodes(ctx, root)
 			if err != nil {
-				log.Printf("Error %v\n", err)
+				log.Printf("Error: %v\n", err)
 				return err
 			}
 
 			log.Printf("INF Checkpoint\n")
 
-			// group definitions by package
-			packages := make(map[astmodel.PackageReference]*astmodel.PackageDefinition)
+			var exported []astmodel.Definition
 			for _, def := range scanner.Definitions {
 
 				shouldExport, reason := configuration.ShouldExport(def)
@@ -97,17 +115,80 @@ func NewGenCommand() (*cobra.Command, error) {
 				case jsonast.Export:
 					log.Printf("Will export %s/%s %s", defRef.PackagePath(), defRef.Name(), motivation)
 
-					pkgRef := defRef.PackageReference
-					if pkg, ok := packages[pkgRef]; ok {
-						pkg.AddDefinition(def)
-					} else {
-						pkg = astmodel.NewPackageDefinition(pkgRef)
-						pkg.AddDefinition(def)
-						packages[pkgRef] = pkg
-					}
+					exported = append(exported, def)
 				}
 			}
 
+			exported, err = configuration.Transform(exported)
+			if err != nil {
+				log.Printf("Error: %v\n", err)
+				return err
+			}
+
+			// group definitions by package
+			packages := make(map[astmodel.PackageReference]*astmodel.PackageDefinition)
+			for _, def := range exported {
+				addToPackage(packages, def)
+			}
+
+			// every version of a group converts through the hub version of that group
+			for _, pkg := range packages {
+				pkg.ConvertThrough(astmodel.HubPackageReference(pkg.PackageReference))
+			}
+
+			hubs, err := astmodel.CreateHubDefinitions(exported)
+			if err != nil {
+				log.Printf("Error: %v\n", err)
+				return err
+			}
+
+			for _, def := range hubs {
+				addToPackage(packages, def)
+			}
+
+			clean, err := cmd.Flags().GetBool("clean")
+			if err != nil {
+				return err
+			}
+
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				differences, err := diffPackages(packages, rootOutputDir, clean, os.Stdout)
+				if err != nil {
+					log.Printf("Error: %v\n", err)
+					return err
+				}
+
+				if differences > 0 {
+					return fmt.Errorf("%d generated files differ from those in %s", differences, rootOutputDir)
+				}
+
+				log.Printf("Generated files match those in %s\n", rootOutputDir)
+				return nil
+			}
+
+			if clean {
+				err = os.RemoveAll(rootOutputDir)
+			} else {
+				// leave hand-written files in place, removing only what we generated last time
+				err = removeGeneratedFiles(rootOutputDir)
+			}
+
+			if err != nil {
+				log.Printf("Error: %v\n", err)
+				return err
+			}
+
+			err = os.MkdirAll(rootOutputDir, 0700)
+			if err != nil {
+				log.Printf("Error %v\n", err)
+				return err
+			}
+
 			// emit each package
 			for _, pkg := range packages {
 
@@ -121,7 +202,11 @@ func NewGenCommand() (*cobra.Command, error) {
 					}
 				}
 
-				pkg.EmitDefinitions(outputDir)
+				err = pkg.EmitDefinitions(outputDir)
+				if err != nil {
+					log.Printf("Error: %v\n", err)
+					return err
+				}
 			}
 
 			log.Printf("Completed writing %v resources\n", len(scanner.Definitions))
@@ -130,17 +215,90 @@ func NewGenCommand() (*cobra.Command, error) {
 		}),
 	}
 
-	cmd.Flags().StringArrayP("resources", "r", nil, "list of resource type / versions to generate")
+	cmd.Flags().StringSliceP("resources", "r", nil, "resources to generate, as {resource type}@{api version} with optional wildcards, such as Microsoft.Network/virtualNetworks@2019-11-01")
 	if err := viper.BindPFlag("resources", cmd.Flags().Lookup("resources")); err != nil {
 		return cmd, err
 	}
 
+	cmd.Flags().String("schema-cache", defaultSchemaCache, "directory of schemas downloaded by fetch, used instead of downloading them if it exists")
+	cmd.Flags().Bool("offline", false, "fail if the schema cache does not exist instead of downloading the schemas, such as in CI")
+	cmd.Flags().String("export-config", "azure-cloud.yaml", "configuration file selecting the schema and types to generate")
+	cmd.Flags().StringP("output", "o", "", "directory to write the generated packages into (default \"apis\")")
+	cmd.Flags().String("package-root", "", "import path of the output directory (default \""+astmodel.DefaultGeneratedPackagesRoot+"\")")
+	cmd.Flags().Bool("clean", false, "remove the output directory before generating, including any hand-written files")
+	cmd.Flags().Bool("dry-run", false, "print a diff of the changes generating would make instead of making them, failing if there are any")
+
 	return cmd, nil
 }
 
-func loadSchema(source string) (*gojsonschema.Schema, error) {
+// setting returns the value of the given flag if it was set, otherwise the configured value if there is one, otherwise
+// the default
+func setting(cmd *cobra.Command, flag string, configured string, fallback string) (string, error) {
+	if cmd.Flags().Changed(flag) {
+		return cmd.Flags().GetString(flag)
+	}
+
+	if configured != "" {
+		return configured, nil
+	}
+
+	return fallback, nil
+}
+
+// removeGeneratedFiles removes the files we generated from within the given directory, leaving any others alone
+func removeGeneratedFiles(dir string) error {
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".go" {
+			return err
+		}
+
+		generated, err := astmodel.IsGeneratedFile(path)
+		if err != nil {
+			return err
+		}
+
+		if generated {
+			return os.Remove(path)
+		}
+
+		return nil
+	})
+
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+func addToPackage(packages map[astmodel.PackageReference]*astmodel.PackageDefinition, def astmodel.Definition) {
+	pkgRef := def.Reference().PackageReference
+	if pkg, ok := packages[pkgRef]; ok {
+		pkg.AddDefinition(def)
+	} else {
+		pkg = astmodel.NewPackageDefinition(pkgRef)
+		pkg.AddDefinition(def)
+		packages[pkgRef] = pkg
+	}
+}
+
+// loadSchema loads the schema at the given URL, along with the schemas it references, from the cache directory if it
+// exists. If it does not, the schemas are downloaded unless offline is set, in which case an error is returned.
+func loadSchema(source string, cacheDir string, offline bool) (*gojsonschema.Schema, error) {
+	var loader gojsonschema.JSONLoader
+	if info, err := os.Stat(cacheDir); err == nil && info.IsDir() {
+		log.Printf("Using schemas cached in %s", cacheDir)
+		loader = schemacache.NewCache(cacheDir).New(source)
+	} else if offline {
+		return nil, fmt.Errorf("schema cache %s not found; run fetch to create it, or omit --offline to download the schemas", cacheDir)
+	} else {
+		// the schemas may change between downloads, so the generated code may differ from one run to the next
+		log.Printf("WRN Schema cache %s not found, downloading schemas from %s; run fetch to generate from a fixed copy", cacheDir, source)
+		loader = gojsonschema.NewReferenceLoader(source)
+	}
+
 	sl := gojsonschema.NewSchemaLoader()
-	schema, err := sl.Compile(gojsonschema.NewReferenceLoader(source))
+	schema, err := sl.Compile(loader)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package gen
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_LoadSchema_GivenExistingCache_LoadsFromCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the schemas exist only in the cache, so nothing is downloaded
+	schema, err := loadSchema("https://schema.example.com/schemas/2020-01-01/root.json", "../../pkg/schemacache/testdata", true)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(schema.Root().ID.String()).To(Equal("https://schema.example.com/schemas/2020-01-01/root.json"))
+}
+
+func Test_LoadSchema_GivenMissingCacheWhenOffline_ReturnsError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := loadSchema("https://schema.example.com/schemas/2020-01-01/root.json", "testdata/missing", true)
+
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("testdata/missing"))
+}
@@ -42,6 +42,8 @@ func newRootCommand() (*cobra.Command, error) {
 
 	cmdFuncs := []func() (*cobra.Command, error){
 		gen.NewGenCommand,
+		gen.NewFetchCommand,
+		gen.NewARMCommand,
 	}
 
 	for _, f := range cmdFuncs {
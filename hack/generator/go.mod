@@ -11,6 +11,7 @@ require (
 	github.com/onsi/ginkgo v1.11.0 // indirect
 	github.com/onsi/gomega v1.8.1
 	github.com/pelletier/go-toml v1.6.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/sebdah/goldie/v2 v2.3.0
 	github.com/spf13/afero v1.2.2 // indirect
 	github.com/spf13/cast v1.3.1 // indirect
@@ -20,6 +21,7 @@ require (
 	github.com/spf13/viper v1.6.1
 	github.com/uber/jaeger-client-go v2.21.1+incompatible // indirect
 	github.com/xeipuuv/gojsonpointer v0.0.0-20190905194746-02993c407bfb // indirect
+	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415
 	github.com/xeipuuv/gojsonschema v1.2.0
 	go.opencensus.io v0.22.2
 	golang.org/x/net v0.0.0-20191209160850-c0dbc17a3553 // indirect
@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package armgen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/sebdah/goldie/v2"
+)
+
+func TestGolden(t *testing.T) {
+	dirs, err := ioutil.ReadDir("testdata")
+	if err != nil {
+		t.Fatalf("unable to list the test packages with: %v", err)
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+
+		name := dir.Name()
+		t.Run(name, func(t *testing.T) {
+			source, err := Generate(filepath.Join("testdata", name))
+			if err != nil {
+				t.Fatalf("unable to generate the ARM conversions with: %v", err)
+			}
+
+			goldie.New(t).Assert(t, name, source)
+		})
+	}
+}
+
+func TestGenerate_RefusesInvalidTags(t *testing.T) {
+	cases := []struct {
+		name   string
+		fields string
+		err    string
+	}{
+		{
+			name:   "reference without an ARM name",
+			fields: "PartRef *azcorev1.KnownTypeReference `json:\"partRef\" group:\"example.infra.azure.com\" kind:\"Part\"`",
+			err:    "a reference needs an arm tag naming its ARM property",
+		},
+		{
+			name:   "unknown option",
+			fields: "Size int `json:\"size\" arm:\"size,sorted\"`",
+			err:    `unknown option "sorted"`,
+		},
+		{
+			name:   "ID fallback on a copied field",
+			fields: "Size int `json:\"size\" arm:\"size,id=Other\"`\nOther string `json:\"other\"`",
+			err:    "only references may fall back to an ID",
+		},
+		{
+			name:   "ID fallback on a slice of references",
+			fields: "PartRefs []azcorev1.KnownTypeReference `json:\"partRefs\" group:\"example.infra.azure.com\" kind:\"Part\" arm:\"parts,id=PartID\"`\nPartID string `json:\"partId\"`",
+			err:    "a slice of references can not be required",
+		},
+		{
+			name:   "missing convert function",
+			fields: "Size int `json:\"size\" arm:\"size,convert=sizeToARM\"`",
+			err:    "unable to find the convert function sizeToARM",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			dir, err := ioutil.TempDir("", "armgen")
+			g.Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+
+			source := `package v1
+
+import azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+
+type (
+	ThingSpecProperties struct {
+		` + c.fields + `
+	}
+
+	ThingSpec struct {
+		APIVersion string               ` + "`json:\"apiVersion\"`" + `
+		Properties *ThingSpecProperties ` + "`json:\"properties,omitempty\"`" + `
+	}
+
+	ThingStatus struct {
+		ID                string ` + "`json:\"id,omitempty\"`" + `
+		DeploymentID      string ` + "`json:\"deploymentId,omitempty\"`" + `
+		ProvisioningState string ` + "`json:\"provisioningState,omitempty\"`" + `
+	}
+
+	Thing struct {
+		Spec   ThingSpec
+		Status ThingStatus
+	}
+)
+`
+			g.Expect(ioutil.WriteFile(filepath.Join(dir, "thing_types.go"), []byte(source), 0644)).To(Succeed())
+
+			_, err = Generate(dir)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(c.err))
+		})
+	}
+}
+
+func TestReceiverName(t *testing.T) {
+	cases := map[string]string{
+		"VirtualNetwork":                  "vn",
+		"NetworkInterfaceIPConfiguration": "niic",
+		"PublicIPAddress":                 "pia",
+		"Disk":                            "d",
+		// the initials of these are a keyword and a name used by the generated code
+		"IPFilter":   "obj",
+		"InboundNat": "obj",
+	}
+
+	for typeName, expected := range cases {
+		g := NewGomegaWithT(t)
+		g.Expect(receiverName(typeName)).To(Equal(expected), typeName)
+	}
+}
@@ -0,0 +1,626 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package armgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type fieldKind int
+
+const (
+	// copiedField is copied into the ARM representation as it is
+	copiedField fieldKind = iota
+	// referenceField is a KnownTypeReference resolved into an ARMIDReference
+	referenceField
+	// referencesField is a slice of KnownTypeReference resolved into a slice of ARMIDReference
+	referencesField
+	// nestedField is a struct of the package which holds references of its own
+	nestedField
+)
+
+const (
+	knownTypeReference = "azcorev1.KnownTypeReference"
+	armIDReference     = "azcorev1.ARMIDReference"
+)
+
+type (
+	// conversion describes the ARM conversion of a type with a Spec and a Status
+	conversion struct {
+		typeName string
+		receiver string
+		// resourceFields are the fields of the Spec copied onto the top level of the ARM resource
+		resourceFields []string
+		hasSKU         bool
+		hasETag        bool
+		properties     *propertiesConversion
+		status         *statusNode
+		owned          []ownedReference
+		// customize is true if the type declares the customizeARMResource hook
+		customize bool
+	}
+
+	// propertiesConversion describes how the Spec properties of a type become the ARM properties
+	propertiesConversion struct {
+		pointer bool
+		// armStruct is the ARM representation of the properties, or nil if they are marshaled as they are
+		armStruct *armStruct
+		// customize is true if the type declares the customizeARMProperties hook
+		customize bool
+	}
+
+	// armStruct is the ARM representation of a struct which holds references, with the references replaced by ARM IDs
+	armStruct struct {
+		name   string
+		source string
+		fields []*armField
+	}
+
+	armField struct {
+		name   string
+		typ    string
+		tag    string
+		kind   fieldKind
+		source string
+		// local is the variable the resolved or nested value is held in before the ARM struct is built
+		local   string
+		group   string
+		refKind string
+		convert string
+		// required drops omitempty, sending the zero value of a field, an empty slice for a nil slice and refusing a
+		// missing reference
+		required   bool
+		isSlice    bool
+		idField    string
+		sourceJSON string
+		idJSON     string
+	}
+
+	// statusNode is a property of the ARM resource recorded in the status of an object, or an object holding such
+	// properties when its children are set
+	statusNode struct {
+		name     string
+		json     string
+		typ      string
+		source   string
+		children []*statusNode
+	}
+
+	// ownedReference is a reference held directly by the properties of a type to an object it owns
+	ownedReference struct {
+		field   string
+		group   string
+		kind    string
+		isSlice bool
+	}
+
+	// builder builds the conversions of the types of an API package
+	builder struct {
+		pkg        *apiPackage
+		armStructs map[string]*armStruct
+		// needsARM caches whether each struct holds references, directly or through nested structs
+		needsARM map[string]bool
+		imports  map[string]string
+	}
+)
+
+func newBuilder(pkg *apiPackage) *builder {
+	return &builder{
+		pkg:        pkg,
+		armStructs: make(map[string]*armStruct),
+		needsARM:   make(map[string]bool),
+		imports:    make(map[string]string),
+	}
+}
+
+// conversion builds the conversion of the type with the given name
+func (b *builder) conversion(typeName string) (*conversion, error) {
+	root := b.pkg.structs[typeName]
+	spec := b.pkg.localStruct(root.field("Spec").Type)
+	status := b.pkg.localStruct(root.field("Status").Type)
+
+	conv := &conversion{
+		typeName:  typeName,
+		receiver:  receiverName(typeName),
+		customize: b.pkg.hasMethod(typeName, customizeResourceHook),
+	}
+
+	if spec.field("APIVersion") == nil {
+		return nil, fmt.Errorf("%s has no APIVersion", spec.name)
+	}
+
+	for _, name := range []string{"Location", "Tags", "Zones", "Kind", "ManagedBy"} {
+		if spec.field(name) != nil {
+			conv.resourceFields = append(conv.resourceFields, name)
+		}
+	}
+
+	if sku := spec.field("SKU"); sku != nil {
+		if exprString(sku.Type) != "string" {
+			return nil, fmt.Errorf("the SKU of %s must be the name of the SKU", spec.name)
+		}
+		conv.hasSKU = true
+	}
+
+	for _, name := range []string{"ID", "DeploymentID", "ProvisioningState"} {
+		if status.field(name) == nil {
+			return nil, fmt.Errorf("%s has no %s", status.name, name)
+		}
+	}
+	conv.hasETag = status.field("ETag") != nil
+
+	if props := spec.field("Properties"); props != nil {
+		propsConv, owned, err := b.properties(typeName, props)
+		if err != nil {
+			return nil, err
+		}
+		conv.properties = propsConv
+		conv.owned = owned
+	}
+
+	statusRoot, err := b.status(status)
+	if err != nil {
+		return nil, err
+	}
+	conv.status = statusRoot
+
+	return conv, nil
+}
+
+func (b *builder) properties(typeName string, props *ast.Field) (*propertiesConversion, []ownedReference, error) {
+	propsStruct := b.pkg.localStruct(props.Type)
+	if propsStruct == nil {
+		return nil, nil, fmt.Errorf("the properties of %s must be a struct of the same package", typeName)
+	}
+
+	_, pointer := props.Type.(*ast.StarExpr)
+	conv := &propertiesConversion{
+		pointer:   pointer,
+		customize: b.pkg.hasMethod(typeName, customizePropertiesHook),
+	}
+
+	needsARM, err := b.structNeedsARM(propsStruct.name, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if needsARM {
+		s, err := b.armStruct(propsStruct)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(s.fields) == 0 && !conv.customize {
+			// every property is a separate resource in ARM, so there are no properties to send
+			delete(b.armStructs, propsStruct.name)
+			return nil, ownedReferences(propsStruct), nil
+		}
+		conv.armStruct = s
+	}
+
+	return conv, ownedReferences(propsStruct), nil
+}
+
+// structNeedsARM returns true if the struct holds references or fields with arm tags, directly or through nested
+// structs of the package, and so needs an ARM representation of its own
+func (b *builder) structNeedsARM(name string, visiting map[string]bool) (bool, error) {
+	if needs, ok := b.needsARM[name]; ok {
+		return needs, nil
+	}
+
+	if visiting == nil {
+		visiting = make(map[string]bool)
+	}
+
+	if visiting[name] {
+		return false, nil
+	}
+	visiting[name] = true
+
+	needs := false
+	for _, f := range b.pkg.structs[name].fields {
+		if _, ok := fieldTag(f).Lookup(armTagName); ok || isReference(f) {
+			needs = true
+			break
+		}
+
+		if nested := b.pkg.localStruct(f.Type); nested != nil {
+			nestedNeeds, err := b.structNeedsARM(nested.name, visiting)
+			if err != nil {
+				return false, err
+			}
+
+			if nestedNeeds {
+				needs = true
+				break
+			}
+		}
+	}
+
+	b.needsARM[name] = needs
+	return needs, nil
+}
+
+// armStruct builds the ARM representation of the struct, along with those of the nested structs it needs
+func (b *builder) armStruct(s *apiStruct) (*armStruct, error) {
+	if built, ok := b.armStructs[s.name]; ok {
+		return built, nil
+	}
+
+	result := &armStruct{
+		name:   unexportedName(s.name) + "ARM",
+		source: s.name,
+	}
+	b.armStructs[s.name] = result
+
+	for _, f := range s.fields {
+		if len(f.Names) == 0 {
+			return nil, fmt.Errorf("embedded field %s of %s is not supported", exprString(f.Type), s.name)
+		}
+
+		for _, n := range f.Names {
+			field, err := b.armField(s, f, n.Name)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert field %s of %s with: %w", n.Name, s.name, err)
+			}
+
+			if field != nil {
+				result.fields = append(result.fields, field)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// armField builds the ARM representation of a field, which is nil if the field is left out
+func (b *builder) armField(s *apiStruct, f *ast.Field, goName string) (*armField, error) {
+	tag, err := parseARMTag(f)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceJSON := jsonName(f, goName)
+	if tag.isExcluded() || sourceJSON == "-" {
+		return nil, nil
+	}
+
+	field := &armField{
+		name:       goName,
+		source:     goName,
+		sourceJSON: sourceJSON,
+		local:      localName(referencedName(goName)),
+	}
+
+	armName := sourceJSON
+	if tag != nil && tag.name != "" {
+		armName = tag.name
+		field.name = armFieldName(goName, armName)
+	}
+
+	if tag != nil {
+		field.required = tag.required
+		field.convert = tag.convert
+		field.idField = tag.idField
+	}
+
+	field.tag = fmt.Sprintf("json:%q", armName+",omitempty")
+	if field.required {
+		field.tag = fmt.Sprintf("json:%q", armName)
+	} else if tag == nil {
+		// a field without an arm tag keeps the JSON of the Spec
+		if jsonTag, ok := fieldTag(f).Lookup("json"); ok {
+			field.tag = fmt.Sprintf("json:%q", jsonTag)
+		}
+	}
+
+	nested := b.pkg.localStruct(f.Type)
+	switch {
+	case isReference(f):
+		if tag == nil || tag.name == "" {
+			return nil, fmt.Errorf("a reference needs an arm tag naming its ARM property")
+		}
+
+		if err := b.setReference(s, f, field); err != nil {
+			return nil, err
+		}
+	case nested != nil:
+		needsARM, err := b.structNeedsARM(nested.name, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if !needsARM {
+			return b.setCopied(s, f, field)
+		}
+
+		if _, pointer := f.Type.(*ast.StarExpr); !pointer {
+			return nil, fmt.Errorf("a struct holding references must be held through a pointer")
+		}
+
+		if field.convert != "" {
+			return nil, fmt.Errorf("a struct holding references can not be converted by a function")
+		}
+
+		nestedStruct, err := b.armStruct(nested)
+		if err != nil {
+			return nil, err
+		}
+
+		field.kind = nestedField
+		field.typ = "*" + nestedStruct.name
+	default:
+		return b.setCopied(s, f, field)
+	}
+
+	if field.convert != "" {
+		return field, b.setConvertedType(field)
+	}
+
+	return field, nil
+}
+
+func (b *builder) setCopied(s *apiStruct, f *ast.Field, field *armField) (*armField, error) {
+	if field.idField != "" {
+		return nil, fmt.Errorf("only references may fall back to an ID")
+	}
+
+	typ, err := s.typeString(f.Type, b.imports)
+	if err != nil {
+		return nil, err
+	}
+	field.typ = typ
+	field.kind = copiedField
+	_, field.isSlice = f.Type.(*ast.ArrayType)
+
+	if field.convert != "" {
+		return field, b.setConvertedType(field)
+	}
+
+	return field, nil
+}
+
+func (b *builder) setReference(s *apiStruct, f *ast.Field, field *armField) error {
+	tag := fieldTag(f)
+	field.group = tag.Get("group")
+	field.refKind = tag.Get("kind")
+	b.imports["azcorev1"] = "github.com/Azure/k8s-infra/apis/core/v1"
+
+	switch exprString(f.Type) {
+	case "*" + knownTypeReference:
+		field.kind = referenceField
+		field.typ = "*" + armIDReference
+	case "[]" + knownTypeReference:
+		field.kind = referencesField
+		field.typ = "[]" + armIDReference
+		if field.required || field.idField != "" {
+			return fmt.Errorf("a slice of references can not be required")
+		}
+	default:
+		return fmt.Errorf("a reference must be a *%s or a []%s", knownTypeReference, knownTypeReference)
+	}
+
+	if field.idField != "" {
+		idField := s.field(field.idField)
+		if idField == nil || exprString(idField.Type) != "string" {
+			return fmt.Errorf("the ID %s of the reference must be a string field of %s", field.idField, s.name)
+		}
+		field.idJSON = jsonName(idField, field.idField)
+	}
+
+	return nil
+}
+
+// setConvertedType sets the type of a field converted by a function to the result type of that function
+func (b *builder) setConvertedType(field *armField) error {
+	fn, ok := b.pkg.funcs[field.convert]
+	if !ok {
+		return fmt.Errorf("unable to find the convert function %s", field.convert)
+	}
+
+	params, results := fn.decl.Type.Params.List, fn.decl.Type.Results
+	if len(params) != 1 || len(params[0].Names) > 1 || results == nil || len(results.List) != 1 || len(results.List[0].Names) > 1 {
+		return fmt.Errorf("the convert function %s must take a single value and return a single value", field.convert)
+	}
+
+	typ, err := typeString(results.List[0].Type, fn.imports, b.imports)
+	if err != nil {
+		return err
+	}
+
+	field.typ = typ
+	return nil
+}
+
+// status builds the tree of the ARM properties recorded in the status, from the arm tags of the status fields. Nested
+// properties are named by dotted paths, such as dnsSettings.fqdn.
+func (b *builder) status(s *apiStruct) (*statusNode, error) {
+	root := &statusNode{}
+	for _, f := range s.fields {
+		tag, err := parseARMTag(f)
+		if err != nil {
+			return nil, err
+		}
+
+		if tag == nil || tag.isExcluded() {
+			continue
+		}
+
+		if tag.required || tag.idField != "" || tag.convert != "" {
+			return nil, fmt.Errorf("the arm tags of the fields of %s only take the path of the property", s.name)
+		}
+
+		typ, err := s.typeString(f.Type, b.imports)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range f.Names {
+			path := tag.name
+			if path == "" {
+				path = jsonName(f, n.Name)
+			}
+
+			node := root
+			segments := strings.Split(path, ".")
+			for _, segment := range segments[:len(segments)-1] {
+				node = node.child(segment)
+			}
+
+			node.children = append(node.children, &statusNode{
+				name:   n.Name,
+				json:   segments[len(segments)-1],
+				typ:    typ,
+				source: n.Name,
+			})
+		}
+	}
+
+	if len(root.children) == 0 {
+		return nil, nil
+	}
+
+	return root, nil
+}
+
+// child returns the object property of the node with the given JSON name, adding it if needed
+func (node *statusNode) child(json string) *statusNode {
+	for _, c := range node.children {
+		if c.json == json && c.source == "" {
+			return c
+		}
+	}
+
+	c := &statusNode{
+		name: exportedName(json),
+		json: json,
+	}
+	node.children = append(node.children, c)
+	return c
+}
+
+// leaves returns the Go paths of the properties recorded in the status, along with the status field of each
+func (node *statusNode) leaves(prefix string) [][2]string {
+	var result [][2]string
+	for _, c := range node.children {
+		if c.source != "" {
+			result = append(result, [2]string{prefix + c.name, c.source})
+			continue
+		}
+		result = append(result, c.leaves(prefix+c.name+".")...)
+	}
+	return result
+}
+
+// sortedARMStructs returns the ARM structs built so far, sorted by name
+func (b *builder) sortedARMStructs() []*armStruct {
+	var result []*armStruct
+	for _, s := range b.armStructs {
+		result = append(result, s)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result
+}
+
+func ownedReferences(s *apiStruct) []ownedReference {
+	var result []ownedReference
+	for _, f := range s.fields {
+		tag := fieldTag(f)
+		if !isReference(f) || tag.Get("owned") != "true" {
+			continue
+		}
+
+		_, isSlice := f.Type.(*ast.ArrayType)
+		for _, n := range f.Names {
+			result = append(result, ownedReference{
+				field:   n.Name,
+				group:   tag.Get("group"),
+				kind:    tag.Get("kind"),
+				isSlice: isSlice,
+			})
+		}
+	}
+	return result
+}
+
+// isReference returns true if the field references other objects, as marked by its group and kind tags
+func isReference(f *ast.Field) bool {
+	tag := fieldTag(f)
+	_, hasGroup := tag.Lookup("group")
+	_, hasKind := tag.Lookup("kind")
+	return hasGroup && hasKind
+}
+
+// reservedNames are the identifiers used by the generated code, which receivers and locals must not shadow
+var reservedNames = map[string]bool{
+	"bits":     true,
+	"ctx":      true,
+	"err":      true,
+	"in":       true,
+	"out":      true,
+	"p":        true,
+	"props":    true,
+	"refs":     true,
+	"res":      true,
+	"resolver": true,
+}
+
+// receiverName returns the initials of the words of the type name, such as vn for VirtualNetwork
+func receiverName(typeName string) string {
+	runes := []rune(typeName)
+	var initials []rune
+	for i, r := range runes {
+		if !unicode.IsUpper(r) {
+			continue
+		}
+
+		startsWord := i == 0 || unicode.IsLower(runes[i-1]) ||
+			(i+1 < len(runes) && unicode.IsLower(runes[i+1]))
+		if startsWord {
+			initials = append(initials, unicode.ToLower(r))
+		}
+	}
+
+	name := string(initials)
+	if name == "" || token.IsKeyword(name) || reservedNames[name] {
+		return "obj"
+	}
+	return name
+}
+
+// armFieldName returns the Go name of a field of an ARM struct, which keeps the initialisms of the field it comes from
+// when it is named after it, such as IPConfigurations for IPConfigurationRefs
+func armFieldName(goName, armName string) string {
+	if name := referencedName(goName); strings.EqualFold(name, armName) {
+		return name
+	}
+	return exportedName(armName)
+}
+
+// referencedName returns the name of a field without the Ref or Refs suffix of a reference, such as Subnets for
+// SubnetRefs
+func referencedName(goName string) string {
+	if name := strings.TrimSuffix(goName, "Refs"); name != goName {
+		return name + "s"
+	}
+	return strings.TrimSuffix(goName, "Ref")
+}
+
+func localName(goName string) string {
+	name := unexportedName(goName)
+	if token.IsKeyword(name) || reservedNames[name] {
+		return name + "Value"
+	}
+	return name
+}
@@ -0,0 +1,318 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package armgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// FileName is the name of the file the ARM conversions of an API package are written to
+	FileName = "zz_generated.arm.go"
+
+	armTagName = "arm"
+
+	// customizeResourceHook is the method a type may declare to adjust its ARM resource once the standard fields are set
+	customizeResourceHook = "customizeARMResource"
+	// customizePropertiesHook is the method a type may declare to adjust its ARM properties before they are marshaled
+	customizePropertiesHook = "customizeARMProperties"
+)
+
+type (
+	// apiPackage holds the declarations of an API package which the ARM conversions are generated from
+	apiPackage struct {
+		name    string
+		structs map[string]*apiStruct
+		funcs   map[string]*apiFunc
+		// methods holds the names of the methods declared on each type
+		methods map[string]map[string]bool
+	}
+
+	// apiStruct is a struct type declared by an API package, along with the imports of the file declaring it
+	apiStruct struct {
+		name    string
+		fields  []*ast.Field
+		imports map[string]string
+	}
+
+	// apiFunc is a function declared by an API package, along with the imports of the file declaring it
+	apiFunc struct {
+		decl    *ast.FuncDecl
+		imports map[string]string
+	}
+
+	// armTag is the parsed arm struct tag of a field
+	armTag struct {
+		name     string
+		required bool
+		idField  string
+		convert  string
+	}
+)
+
+// loadPackage parses the hand-written Go files of the API package in dir
+func loadPackage(dir string) (*apiPackage, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, isSourceFile, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s with: %w", dir, err)
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected a single package in %s, but found %d", dir, len(pkgs))
+	}
+
+	pkg := &apiPackage{
+		structs: make(map[string]*apiStruct),
+		funcs:   make(map[string]*apiFunc),
+		methods: make(map[string]map[string]bool),
+	}
+
+	for name, p := range pkgs {
+		pkg.name = name
+		for _, file := range p.Files {
+			pkg.addFile(file)
+		}
+	}
+
+	return pkg, nil
+}
+
+// isSourceFile filters out tests and generated files, including any previous output of the ARM generator
+func isSourceFile(info os.FileInfo) bool {
+	name := info.Name()
+	return !strings.HasSuffix(name, "_test.go") && !strings.HasPrefix(name, "zz_generated")
+}
+
+func (pkg *apiPackage) addFile(file *ast.File) {
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					pkg.structs[typeSpec.Name.Name] = &apiStruct{
+						name:    typeSpec.Name.Name,
+						fields:  structType.Fields.List,
+						imports: imports,
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				pkg.funcs[d.Name.Name] = &apiFunc{
+					decl:    d,
+					imports: imports,
+				}
+				continue
+			}
+
+			recv := localTypeName(d.Recv.List[0].Type)
+			if pkg.methods[recv] == nil {
+				pkg.methods[recv] = make(map[string]bool)
+			}
+			pkg.methods[recv][d.Name.Name] = true
+		}
+	}
+}
+
+// rootTypes returns the names of the types which have a Spec and a Status declared in the package, sorted by name
+func (pkg *apiPackage) rootTypes() []string {
+	var roots []string
+	for name, s := range pkg.structs {
+		spec := s.field("Spec")
+		status := s.field("Status")
+		if spec == nil || status == nil {
+			continue
+		}
+
+		if pkg.localStruct(spec.Type) != nil && pkg.localStruct(status.Type) != nil {
+			roots = append(roots, name)
+		}
+	}
+
+	sort.Strings(roots)
+	return roots
+}
+
+// localStruct returns the struct declared in the package which expr names, either directly or through a pointer
+func (pkg *apiPackage) localStruct(expr ast.Expr) *apiStruct {
+	return pkg.structs[localTypeName(expr)]
+}
+
+func (pkg *apiPackage) hasMethod(typeName, method string) bool {
+	return pkg.methods[typeName][method]
+}
+
+// field returns the field of the struct with the given name, or nil if there is none
+func (s *apiStruct) field(name string) *ast.Field {
+	for _, f := range s.fields {
+		for _, n := range f.Names {
+			if n.Name == name {
+				return f
+			}
+		}
+	}
+
+	return nil
+}
+
+// typeString renders the type expression of a field of the struct, recording the imports it needs
+func (s *apiStruct) typeString(expr ast.Expr, imports map[string]string) (string, error) {
+	return typeString(expr, s.imports, imports)
+}
+
+// typeString renders a type expression of a file with the given imports, recording the imports it needs
+func typeString(expr ast.Expr, fileImports map[string]string, imports map[string]string) (string, error) {
+	var err error
+	ast.Inspect(expr, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if ident, ok := sel.X.(*ast.Ident); ok {
+			path, found := fileImports[ident.Name]
+			if !found {
+				err = fmt.Errorf("unable to find the import of %s", ident.Name)
+				return false
+			}
+			imports[ident.Name] = path
+		}
+		return false
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return exprString(expr), nil
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+// localTypeName returns the name of the type expr refers to if it is a type of the same package, or a pointer to one
+func localTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+func fieldTag(f *ast.Field) reflect.StructTag {
+	if f.Tag == nil {
+		return ""
+	}
+
+	tag, _ := strconv.Unquote(f.Tag.Value)
+	return reflect.StructTag(tag)
+}
+
+// jsonName returns the name of the field in JSON, or "-" if it is not serialized
+func jsonName(f *ast.Field, goName string) string {
+	tag, ok := fieldTag(f).Lookup("json")
+	if !ok {
+		return goName
+	}
+
+	name := strings.Split(tag, ",")[0]
+	if name == "" {
+		return goName
+	}
+	return name
+}
+
+// parseARMTag parses the arm tag of a field, which takes the form name[,required][,id=Field][,convert=func]. The
+// returned tag is nil if the field has no arm tag.
+func parseARMTag(f *ast.Field) (*armTag, error) {
+	value, ok := fieldTag(f).Lookup(armTagName)
+	if !ok {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	tag := &armTag{name: parts[0]}
+	for _, option := range parts[1:] {
+		switch {
+		case option == "required":
+			tag.required = true
+		case strings.HasPrefix(option, "id="):
+			tag.idField = strings.TrimPrefix(option, "id=")
+		case strings.HasPrefix(option, "convert="):
+			tag.convert = strings.TrimPrefix(option, "convert=")
+		default:
+			return nil, fmt.Errorf("unknown option %q in arm tag %q", option, value)
+		}
+	}
+
+	return tag, nil
+}
+
+// isExcluded returns true if the field is left out of the ARM representation
+func (tag *armTag) isExcluded() bool {
+	return tag != nil && tag.name == "-"
+}
+
+// initialisms are the leading words of JSON property names which are written in upper case in Go identifiers
+var initialisms = []string{"dns", "http", "id", "ip", "tls", "uri", "url", "vm"}
+
+// exportedName returns the exported Go identifier for a JSON property name, such as DNSSettings for dnsSettings
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+
+	for _, initialism := range initialisms {
+		rest := strings.TrimPrefix(name, initialism)
+		if rest != name && (rest == "" || unicode.IsUpper(rune(rest[0]))) {
+			return strings.ToUpper(initialism) + rest
+		}
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// unexportedName returns the unexported Go identifier for an exported one
+func unexportedName(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
@@ -0,0 +1,459 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package armgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Azure/k8s-infra/hack/generator/pkg/astmodel"
+)
+
+// writer accumulates the source of the generated file
+type writer struct {
+	bytes.Buffer
+}
+
+func (w *writer) line(format string, args ...interface{}) {
+	fmt.Fprintf(w, format+"\n", args...)
+}
+
+// Generate returns the source of the ARM conversions of the types of the API package in dir. Every type with a Spec
+// and a Status gets ToARM and FromARM methods, built from the struct tags of its fields:
+//
+//   - the references of the Spec properties, marked by their group and kind tags, need an arm tag naming their ARM
+//     property, such as `arm:"subnets"`, and are resolved into ARM IDs
+//   - `arm:"-"` leaves a field of the Spec properties out of the ARM properties
+//   - `arm:"name,required"` drops omitempty, so a zero value is sent, a nil slice is sent empty and a missing reference
+//     is an error
+//   - `arm:"name,id=Field"` falls back to the ARM ID held by Field when a reference is not set
+//   - `arm:"name,convert=func"` passes the value, resolved if it is a reference, through a function of the package
+//   - `arm:"path.to.property"` on a Status field records that property of the provisioned ARM resource
+//
+// A type may also declare customizeARMResource and customizeARMProperties methods, which are called with the ARM
+// resource once its top level fields are set and with the ARM properties before they are marshaled.
+func Generate(dir string) ([]byte, error) {
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	b := newBuilder(pkg)
+	b.imports["azcorev1"] = "github.com/Azure/k8s-infra/apis/core/v1"
+	b.imports["zips"] = "github.com/Azure/k8s-infra/pkg/zips"
+
+	var conversions []*conversion
+	for _, name := range pkg.rootTypes() {
+		conv, err := b.conversion(name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build the ARM conversion of %s with: %w", name, err)
+		}
+		conversions = append(conversions, conv)
+	}
+
+	if len(conversions) == 0 {
+		return nil, fmt.Errorf("no types with a Spec and a Status found in %s", dir)
+	}
+
+	w := new(writer)
+	w.line("/*")
+	w.line("Copyright (c) Microsoft Corporation.")
+	w.line("Licensed under the MIT license.")
+	w.line("*/")
+	w.line("")
+	w.line("// %s", astmodel.GeneratedFileHeader)
+	w.line("")
+	w.line("package %s", pkg.name)
+	w.line("")
+	w.writeImports(b.imports, usesJSON(conversions))
+	w.writeTypes(b.sortedARMStructs(), conversions)
+
+	for _, conv := range conversions {
+		w.writeToARM(conv)
+		w.writeFromARM(conv)
+		w.writeAccessors(conv)
+		w.writeOwnedReferences(conv)
+	}
+
+	for _, s := range b.sortedARMStructs() {
+		w.writeStructToARM(s)
+	}
+
+	source, err := format.Source(w.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format the ARM conversions of %s with: %w", dir, err)
+	}
+
+	return source, nil
+}
+
+// GenerateFile writes the ARM conversions of the API package in dir to the zz_generated.arm.go file of the package
+func GenerateFile(dir string) error {
+	source, err := Generate(dir)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(dir, FileName), source, 0644)
+}
+
+func usesJSON(conversions []*conversion) bool {
+	for _, conv := range conversions {
+		if conv.properties != nil {
+			return true
+		}
+	}
+	return hasStatus(conversions)
+}
+
+func hasStatus(conversions []*conversion) bool {
+	for _, conv := range conversions {
+		if conv.status != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *writer) writeImports(imports map[string]string, withJSON bool) {
+	w.line("import (")
+	w.line("%q", "context")
+	if withJSON {
+		w.line("%q", "encoding/json")
+	}
+	w.line("%q", "errors")
+	if withJSON {
+		w.line("%q", "fmt")
+	}
+	w.line("")
+
+	var names []string
+	for name := range imports {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return imports[names[i]] < imports[names[j]]
+	})
+
+	for _, name := range names {
+		path := imports[name]
+		if path[strings.LastIndex(path, "/")+1:] == name {
+			w.line("%q", path)
+		} else {
+			w.line("%s %q", name, path)
+		}
+	}
+	w.line(")")
+	w.line("")
+}
+
+func (w *writer) writeTypes(structs []*armStruct, conversions []*conversion) {
+	if len(structs) == 0 && !hasStatus(conversions) {
+		return
+	}
+
+	w.line("type (")
+	for _, s := range structs {
+		w.line("// %s is the ARM representation of %s", s.name, s.source)
+		w.line("// +kubebuilder:object:generate=false")
+		w.line("%s struct {", s.name)
+		for _, f := range s.fields {
+			w.line("%s %s `%s`", f.name, f.typ, f.tag)
+		}
+		w.line("}")
+		w.line("")
+	}
+
+	for _, conv := range conversions {
+		if conv.status == nil {
+			continue
+		}
+
+		w.line("// %s holds the ARM properties recorded in the status of a %s", statusARMName(conv), conv.typeName)
+		w.line("// +kubebuilder:object:generate=false")
+		w.line("%s struct {", statusARMName(conv))
+		w.writeStatusFields(conv.status)
+		w.line("}")
+		w.line("")
+	}
+	w.line(")")
+	w.line("")
+}
+
+func (w *writer) writeStatusFields(node *statusNode) {
+	for _, c := range node.children {
+		if c.source != "" {
+			w.line("%s %s `json:%q`", c.name, c.typ, c.json+",omitempty")
+			continue
+		}
+
+		w.line("%s struct {", c.name)
+		w.writeStatusFields(c)
+		w.line("} `json:%q`", c.json)
+	}
+}
+
+func statusARMName(conv *conversion) string {
+	return unexportedName(conv.typeName) + "StatusARM"
+}
+
+func (w *writer) writeToARM(conv *conversion) {
+	r := conv.receiver
+	w.line("// ToARM converts the %s into an ARM resource", conv.typeName)
+	w.line("func (%s *%s) ToARM(ctx context.Context, resolver azcorev1.ARMReferenceResolver) (*zips.Resource, error) {", r, conv.typeName)
+	w.line("if %s.Spec.APIVersion == \"\" {", r)
+	w.line("return nil, errors.New(\"apiVersion not found in spec\")")
+	w.line("}")
+	w.line("")
+	w.line("res := &zips.Resource{")
+	w.line("ID: %s.Status.ID,", r)
+	w.line("Type: %s.ResourceType(),", r)
+	w.line("APIVersion: %s.Spec.APIVersion,", r)
+	for _, name := range conv.resourceFields {
+		w.line("%s: %s.Spec.%s,", name, r, name)
+	}
+	w.line("DeploymentID: %s.Status.DeploymentID,", r)
+	w.line("ProvisioningState: zips.ProvisioningState(%s.Status.ProvisioningState),", r)
+	if conv.hasETag {
+		w.line("ETag: %s.Status.ETag,", r)
+	}
+	w.line("}")
+	w.line("res.SetAnnotations(%s.GetAnnotations())", r)
+	if conv.hasSKU {
+		w.line("if %s.Spec.SKU != \"\" {", r)
+		w.line("res.SKU = &zips.SKU{Name: %s.Spec.SKU}", r)
+		w.line("}")
+	}
+	if conv.customize {
+		w.line("%s.%s(res)", r, customizeResourceHook)
+	}
+	w.line("")
+
+	if props := conv.properties; props != nil {
+		w.line("if resolver == nil {")
+		w.line("// without a resolver only the identity of the resource is needed, such as to delete it")
+		w.line("return res, nil")
+		w.line("}")
+		w.line("")
+
+		if props.pointer {
+			w.line("if p := %s.Spec.Properties; p != nil {", r)
+			w.writePropertiesToARM(conv, "p", "*p")
+			w.line("}")
+		} else {
+			source := r + ".Spec.Properties"
+			w.writePropertiesToARM(conv, source, source)
+		}
+		w.line("")
+	}
+
+	w.line("return res, nil")
+	w.line("}")
+	w.line("")
+}
+
+// writePropertiesToARM marshals the Spec properties, held by source, into the ARM properties. value is the expression
+// of the properties themselves, which differs from source when they are held through a pointer.
+func (w *writer) writePropertiesToARM(conv *conversion, source, value string) {
+	props := conv.properties
+	marshaled := "props"
+	switch {
+	case props.armStruct != nil:
+		w.line("props, err := %s.toARM(ctx, resolver)", source)
+		w.line("if err != nil {")
+		w.line("return nil, err")
+		w.line("}")
+		if props.customize {
+			w.line("%s.%s(props)", conv.receiver, customizePropertiesHook)
+		}
+		w.line("")
+	case props.customize:
+		w.line("props := %s", value)
+		w.line("%s.%s(&props)", conv.receiver, customizePropertiesHook)
+		w.line("")
+	default:
+		marshaled = source
+	}
+
+	w.line("bits, err := json.Marshal(%s)", marshaled)
+	w.line("if err != nil {")
+	w.line("return nil, fmt.Errorf(\"unable to marshal ARM properties with: %%w\", err)")
+	w.line("}")
+	w.line("res.Properties = bits")
+}
+
+func (w *writer) writeFromARM(conv *conversion) {
+	r := conv.receiver
+	w.line("// FromARM sets the status of the %s from the ARM resource", conv.typeName)
+	w.line("func (%s *%s) FromARM(res *zips.Resource) error {", r, conv.typeName)
+	w.line("%s.Status.ID = res.ID", r)
+	w.line("%s.Status.DeploymentID = res.DeploymentID", r)
+	w.line("%s.Status.ProvisioningState = string(res.ProvisioningState)", r)
+
+	if !conv.hasETag && conv.status == nil {
+		w.line("return nil")
+		w.line("}")
+		w.line("")
+		return
+	}
+
+	w.line("")
+	w.line("if res.ProvisioningState != zips.SucceededProvisioningState {")
+	w.line("// the properties are those which were requested, rather than those returned by Azure")
+	w.line("return nil")
+	w.line("}")
+	w.line("")
+
+	if conv.hasETag {
+		w.line("%s.Status.ETag = res.ETag", r)
+		if conv.status != nil {
+			w.line("")
+		}
+	}
+
+	if conv.status != nil {
+		w.line("if len(res.Properties) == 0 {")
+		w.line("return nil")
+		w.line("}")
+		w.line("")
+		w.line("var props %s", statusARMName(conv))
+		w.line("if err := json.Unmarshal(res.Properties, &props); err != nil {")
+		w.line("return fmt.Errorf(\"unable to unmarshal ARM properties with: %%w\", err)")
+		w.line("}")
+		w.line("")
+		for _, leaf := range conv.status.leaves("props.") {
+			w.line("%s.Status.%s = %s", r, leaf[1], leaf[0])
+		}
+	}
+
+	w.line("return nil")
+	w.line("}")
+	w.line("")
+}
+
+func (w *writer) writeAccessors(conv *conversion) {
+	r := conv.receiver
+	w.line("// GetARMID returns the ID of the ARM resource of the %s, which is empty until it has been applied", conv.typeName)
+	w.line("func (%s *%s) GetARMID() string {", r, conv.typeName)
+	w.line("return %s.Status.ID", r)
+	w.line("}")
+	w.line("")
+	w.line("// GetProvisioningState returns the provisioning state of the ARM resource of the %s", conv.typeName)
+	w.line("func (%s *%s) GetProvisioningState() string {", r, conv.typeName)
+	w.line("return %s.Status.ProvisioningState", r)
+	w.line("}")
+	w.line("")
+}
+
+func (w *writer) writeOwnedReferences(conv *conversion) {
+	if len(conv.owned) == 0 {
+		return
+	}
+
+	r := conv.receiver
+	w.line("// OwnedReferences returns the references of the %s to the objects it owns", conv.typeName)
+	w.line("func (%s *%s) OwnedReferences() []azcorev1.TypedReference {", r, conv.typeName)
+	if conv.properties == nil || conv.properties.pointer {
+		w.line("p := %s.Spec.Properties", r)
+		w.line("if p == nil {")
+		w.line("return nil")
+		w.line("}")
+	} else {
+		w.line("p := &%s.Spec.Properties", r)
+	}
+	w.line("")
+	w.line("var refs []azcorev1.TypedReference")
+	for _, owned := range conv.owned {
+		if owned.isSlice {
+			w.line("for _, ref := range p.%s {", owned.field)
+		} else {
+			w.line("if ref := p.%s; ref != nil {", owned.field)
+		}
+		w.line("refs = append(refs, azcorev1.TypedReference{")
+		w.line("APIGroup: %q,", owned.group)
+		w.line("Kind: %q,", owned.kind)
+		w.line("NameSpace: ref.Namespace,")
+		w.line("Name: ref.Name,")
+		w.line("})")
+		w.line("}")
+	}
+	w.line("return refs")
+	w.line("}")
+	w.line("")
+}
+
+func (w *writer) writeStructToARM(s *armStruct) {
+	w.line("// toARM converts the %s into its ARM representation, resolving references into ARM IDs", s.source)
+	w.line("func (in *%s) toARM(ctx context.Context, resolver azcorev1.ARMReferenceResolver) (*%s, error) {", s.source, s.name)
+	w.line("if in == nil {")
+	w.line("return nil, nil")
+	w.line("}")
+	w.line("")
+
+	for _, f := range s.fields {
+		switch f.kind {
+		case referenceField:
+			w.line("%s, err := azcorev1.ResolveARMIDReference(ctx, resolver, in.%s, %q, %q)", f.local, f.source, f.group, f.refKind)
+		case referencesField:
+			w.line("%s, err := azcorev1.ResolveARMIDReferences(ctx, resolver, in.%s, %q, %q)", f.local, f.source, f.group, f.refKind)
+		case nestedField:
+			w.line("%s, err := in.%s.toARM(ctx, resolver)", f.local, f.source)
+		default:
+			continue
+		}
+		w.line("if err != nil {")
+		w.line("return nil, err")
+		w.line("}")
+
+		if f.idField != "" {
+			w.line("if %s == nil && in.%s != \"\" {", f.local, f.idField)
+			w.line("%s = &azcorev1.ARMIDReference{ID: in.%s}", f.local, f.idField)
+			w.line("}")
+		}
+
+		if f.kind == referenceField && f.required {
+			w.line("if %s == nil {", f.local)
+			if f.idField != "" {
+				w.line("return nil, errors.New(%q)", fmt.Sprintf("either %s or %s is required", f.sourceJSON, f.idJSON))
+			} else {
+				w.line("return nil, errors.New(%q)", fmt.Sprintf("%s is required", f.sourceJSON))
+			}
+			w.line("}")
+		}
+		w.line("")
+	}
+
+	w.line("out := &%s{", s.name)
+	for _, f := range s.fields {
+		value := "in." + f.source
+		if f.kind != copiedField {
+			value = f.local
+		}
+
+		if f.convert != "" {
+			value = fmt.Sprintf("%s(%s)", f.convert, value)
+		}
+		w.line("%s: %s,", f.name, value)
+	}
+	w.line("}")
+
+	for _, f := range s.fields {
+		if f.kind == copiedField && f.required && f.isSlice && f.convert == "" {
+			w.line("if out.%s == nil {", f.name)
+			w.line("out.%s = %s{}", f.name, f.typ)
+			w.line("}")
+		}
+	}
+
+	w.line("return out, nil")
+	w.line("}")
+	w.line("")
+}
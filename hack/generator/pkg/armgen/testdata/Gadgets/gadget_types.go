@@ -0,0 +1,64 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+type (
+	GadgetProperties struct {
+		AddressPrefix string `json:"addressPrefix,omitempty"`
+	}
+
+	GadgetSpec struct {
+		APIVersion string           `json:"apiVersion"`
+		Properties GadgetProperties `json:"properties,omitempty"`
+	}
+
+	GadgetStatus struct {
+		ID                string `json:"id,omitempty"`
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+		AllocatedPrefix   string `json:"allocatedPrefix,omitempty"`
+	}
+
+	Gadget struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   GadgetSpec   `json:"spec,omitempty"`
+		Status GadgetStatus `json:"status,omitempty"`
+	}
+
+	GadgetZoneSpecProperties struct {
+		GadgetRefs []azcorev1.KnownTypeReference `json:"gadgetRefs,omitempty" group:"example.infra.azure.com" kind:"Gadget" owned:"true" arm:"-"`
+	}
+
+	GadgetZoneSpec struct {
+		APIVersion string                    `json:"apiVersion"`
+		Kind       string                    `json:"kind,omitempty"`
+		Zones      []string                  `json:"zones,omitempty"`
+		Properties *GadgetZoneSpecProperties `json:"properties,omitempty"`
+	}
+
+	GadgetZoneStatus struct {
+		ID                string `json:"id,omitempty"`
+		DeploymentID      string `json:"deploymentId,omitempty"`
+		ProvisioningState string `json:"provisioningState,omitempty"`
+	}
+
+	GadgetZone struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   GadgetZoneSpec   `json:"spec,omitempty"`
+		Status GadgetZoneStatus `json:"status,omitempty"`
+	}
+)
+
+func (g *Gadget) customizeARMProperties(props *GadgetProperties) {
+	if props.AddressPrefix == "" {
+		props.AddressPrefix = g.Status.AllocatedPrefix
+	}
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	"github.com/Azure/k8s-infra/pkg/zips"
+)
+
+type (
+	WidgetSettings struct {
+		PartRefs []azcorev1.KnownTypeReference `json:"partRefs,omitempty" group:"example.infra.azure.com" kind:"Part" arm:"parts"`
+		Size     int                           `json:"size,omitempty"`
+	}
+
+	WidgetSpecProperties struct {
+		Color       string                        `json:"color,omitempty"`
+		Labels      []string                      `json:"labels,omitempty" arm:",required"`
+		GearRefs    []azcorev1.KnownTypeReference `json:"gearRefs,omitempty" group:"example.infra.azure.com" kind:"Gear" owned:"true" arm:"gears"`
+		FrameRef    *azcorev1.KnownTypeReference  `json:"frameRef,omitempty" group:"example.infra.azure.com" kind:"Frame" arm:"frame,required,id=FrameID"`
+		FrameID     string                        `json:"frameId,omitempty" arm:"-"`
+		MotorRef    *azcorev1.KnownTypeReference  `json:"motorRef,omitempty" group:"example.infra.azure.com" kind:"Motor" owned:"true" arm:"motor"`
+		SpringRefs  []azcorev1.KnownTypeReference `json:"springRefs,omitempty" group:"example.infra.azure.com" kind:"Spring" arm:"springProfile,convert=springProfileToARM"`
+		Settings    *WidgetSettings               `json:"settings,omitempty"`
+		BoxRefs     []azcorev1.KnownTypeReference `json:"boxRefs,omitempty" group:"example.infra.azure.com" kind:"Box" owned:"true" arm:"-"`
+		Description string                        `json:"-"`
+	}
+
+	WidgetSpec struct {
+		APIVersion       string                       `json:"apiVersion"`
+		ResourceGroupRef *azcorev1.KnownTypeReference `json:"resourceGroupRef" group:"microsoft.resources.infra.azure.com" kind:"ResourceGroup"`
+		Location         string                       `json:"location"`
+		SKU              string                       `json:"sku,omitempty"`
+		Tags             map[string]string            `json:"tags,omitempty"`
+		Properties       *WidgetSpecProperties        `json:"properties,omitempty"`
+	}
+
+	WidgetStatus struct {
+		ID                string                   `json:"id,omitempty"`
+		DeploymentID      string                   `json:"deploymentId,omitempty"`
+		ProvisioningState string                   `json:"provisioningState,omitempty"`
+		ETag              string                   `json:"etag,omitempty"`
+		SerialNumber      string                   `json:"serialNumber,omitempty" arm:"serialNumber"`
+		FQDN              string                   `json:"fqdn,omitempty" arm:"dnsSettings.fqdn"`
+		Owner             *azcorev1.ARMIDReference `json:"owner,omitempty" arm:"ownership.owner"`
+		Conditions        azcorev1.Conditions      `json:"conditions,omitempty"`
+	}
+
+	Widget struct {
+		metav1.TypeMeta   `json:",inline"`
+		metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		Spec   WidgetSpec   `json:"spec,omitempty"`
+		Status WidgetStatus `json:"status,omitempty"`
+	}
+
+	springProfileARM struct {
+		Springs []azcorev1.ARMIDReference `json:"springs"`
+	}
+)
+
+func springProfileToARM(springs []azcorev1.ARMIDReference) *springProfileARM {
+	return &springProfileARM{Springs: springs}
+}
+
+func (w *Widget) customizeARMResource(res *zips.Resource) {
+	res.Location = "global"
+}
@@ -29,6 +29,10 @@ var _ Type = (*DefinitionName)(nil)
 
 // AsType implements Type for DefinitionName
 func (dn *DefinitionName) AsType() ast.Expr {
+	if dn.IsLibrary() {
+		return &ast.SelectorExpr{X: ast.NewIdent(dn.PackageName()), Sel: ast.NewIdent(dn.name)}
+	}
+
 	return ast.NewIdent(dn.name)
 }
 
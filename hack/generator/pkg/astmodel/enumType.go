@@ -45,8 +45,51 @@ func (enum *EnumType) References(t Type) bool {
 	return enum.DefinitionName.References(t)
 }
 
+// Equals returns true if the passed type is an enum with the same base type and values, false otherwise
+func (enum *EnumType) Equals(t Type) bool {
+	if enum == t {
+		return true
+	}
+
+	if other, ok := t.(*EnumType); ok {
+		if !enum.BaseType.Equals(other.BaseType) || len(enum.Options) != len(other.Options) {
+			return false
+		}
+
+		values := make(map[string]bool)
+		for _, option := range enum.Options {
+			values[option.Value] = true
+		}
+
+		for _, option := range other.Options {
+			if !values[option.Value] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return enum.DefinitionName.Equals(t)
+}
+
+// Values returns the JSON values of the options of the enum
+func (enum *EnumType) Values() []interface{} {
+	var result []interface{}
+	for _, option := range enum.Options {
+		result = append(result, option.Value)
+	}
+
+	return result
+}
+
 // RelatedDefinitions implements the HasRelatedDefinitions interface for EnumType
 func (enum *EnumType) RelatedDefinitions(ref PackageReference, namehint string, idFactory IdentifierFactory) []Definition {
+	if enum.name != "" {
+		// already defined from another context where the same enum is used
+		return nil
+	}
+
 	identifier := idFactory.CreateEnumIdentifier(namehint)
 	enum.DefinitionName = DefinitionName{PackageReference: ref, name: identifier}
 	var definition Definition = &EnumDefinition{EnumType: *enum}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_EnumTypeEquals_GivenSameValuesInDifferentOrder_ReturnsTrue(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tcp := EnumValue{Identifier: "Tcp", Value: `"Tcp"`}
+	udp := EnumValue{Identifier: "Udp", Value: `"Udp"`}
+	enum := NewEnumType(StringType, []EnumValue{tcp, udp})
+	other := NewEnumType(StringType, []EnumValue{udp, tcp})
+
+	g.Expect(enum.Equals(other)).To(BeTrue())
+}
+
+func Test_EnumTypeEquals_GivenDifferentValues_ReturnsFalse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tcp := EnumValue{Identifier: "Tcp", Value: `"Tcp"`}
+	udp := EnumValue{Identifier: "Udp", Value: `"Udp"`}
+	enum := NewEnumType(StringType, []EnumValue{tcp, udp})
+	other := NewEnumType(StringType, []EnumValue{tcp})
+
+	g.Expect(enum.Equals(other)).To(BeFalse())
+}
+
+func Test_EnumTypeRelatedDefinitions_WhenAlreadyDefined_ReturnsNothing(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ref := NewPackageReference("group", "v20200101")
+	enum := NewEnumType(StringType, []EnumValue{{Identifier: "Tcp", Value: `"Tcp"`}})
+
+	first := enum.RelatedDefinitions(ref, "Endpoint.Protocol", NewIdentifierFactory())
+	second := enum.RelatedDefinitions(ref, "Replica.Protocol", NewIdentifierFactory())
+
+	g.Expect(first).To(HaveLen(1))
+	g.Expect(second).To(BeEmpty())
+	g.Expect(enum.Name()).To(Equal("EndpointProtocol"))
+}
@@ -21,6 +21,13 @@ type FieldDefinition struct {
 	jsonName    string
 	description string
 	validations []Validation
+	tags        []fieldTag
+}
+
+// fieldTag is a struct tag of a field other than its JSON name
+type fieldTag struct {
+	key   string
+	value string
 }
 
 // NewFieldDefinition is a factory method for creating a new FieldDefinition
@@ -57,6 +64,48 @@ func (field *FieldDefinition) FieldType() Type {
 	return field.fieldType
 }
 
+// WithName returns a new FieldDefinition with the specified name; the JSON name is unchanged
+func (field *FieldDefinition) WithName(fieldName FieldName) *FieldDefinition {
+	result := *field
+	result.fieldName = fieldName
+	return &result
+}
+
+// WithElementType returns a new FieldDefinition whose type is the specified type, kept optional or wrapped in an
+// array if the existing type is. Validations of the values of the existing type are dropped, as they need not suit
+// the new one; whether the field is required, and how many items it may have, are kept.
+func (field *FieldDefinition) WithElementType(elementType Type) *FieldDefinition {
+	var replace func(t Type) Type
+	replace = func(t Type) Type {
+		switch it := t.(type) {
+		case *OptionalType:
+			return NewOptionalType(replace(it.element))
+		case *ArrayType:
+			return NewArrayType(replace(it.element))
+		default:
+			return elementType
+		}
+	}
+
+	result := *field
+	result.fieldType = replace(field.fieldType)
+	result.validations = nil
+	for _, validation := range field.validations {
+		if !elementMarkers[validation.name] {
+			result.validations = append(result.validations, validation)
+		}
+	}
+
+	return &result
+}
+
+// WithTag returns a new FieldDefinition with the specified struct tag added after its JSON name
+func (field *FieldDefinition) WithTag(key string, value string) *FieldDefinition {
+	result := *field
+	result.tags = append(append([]fieldTag(nil), field.tags...), fieldTag{key, value})
+	return &result
+}
+
 // WithDescription returns a new FieldDefinition with the specified description
 func (field *FieldDefinition) WithDescription(description *string) *FieldDefinition {
 	if description == nil {
@@ -96,7 +145,7 @@ func (field *FieldDefinition) AsField() *ast.Field {
 		Type:  field.FieldType().AsType(),
 		Tag: &ast.BasicLit{
 			Kind:  token.STRING,
-			Value: fmt.Sprintf("`json:%q`", field.jsonName),
+			Value: field.structTag(),
 		},
 	}
 
@@ -126,6 +175,16 @@ func (field *FieldDefinition) AsField() *ast.Field {
 	return result
 }
 
+// structTag generates the struct tag of the field, starting with its JSON name
+func (field *FieldDefinition) structTag() string {
+	tag := fmt.Sprintf("json:%q", field.jsonName)
+	for _, t := range field.tags {
+		tag += fmt.Sprintf(" %s:%q", t.key, t.value)
+	}
+
+	return "`" + tag + "`"
+}
+
 // Equals tests to see if the specified FieldDefinition specifies the same field
 func (field *FieldDefinition) Equals(f *FieldDefinition) bool {
 	return field == f || (field.fieldName == f.fieldName && field.fieldType.Equals(f.fieldType))
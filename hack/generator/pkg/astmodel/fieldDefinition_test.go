@@ -96,3 +96,40 @@ func TestFieldDefinition_Equals_WhenGivenFieldDefinition_ReturnsExpectedResult(t
 	}
 }
 
+
+func Test_FieldDefinitionWithElementType_GivenOptionalArray_KeepsWrappers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	field := NewFieldDefinition("Ids", "ids", NewOptionalType(NewArrayType(StringType)))
+
+	result := field.WithElementType(IntType)
+
+	g.Expect(result.fieldType.Equals(NewOptionalType(NewArrayType(IntType)))).To(BeTrue())
+	g.Expect(field.fieldType.Equals(NewOptionalType(NewArrayType(StringType)))).To(BeTrue())
+}
+
+func Test_FieldDefinitionWithElementType_GivenValidations_KeepsOnlyThoseOfTheField(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	field := NewFieldDefinition("Ids", "ids", NewArrayType(StringType)).
+		MakeRequired().
+		WithValidation(ValidateMaxItems(3)).
+		WithValidation(ValidateUniqueItems()).
+		WithValidation(ValidatePattern("^[a-z]+$")).
+		WithValidation(ValidateMaxLength(10))
+
+	result := field.WithElementType(IntType)
+
+	g.Expect(result.validations).To(Equal([]Validation{ValidateRequired(), ValidateMaxItems(3)}))
+	g.Expect(field.validations).To(HaveLen(5))
+}
+
+func Test_FieldDefinitionAsAst_GivenTags_AddsTagsAfterJSONName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	field := NewFieldDefinition("VnetRef", "vnetRef", StringType).WithTag("group", "microsoft.network").WithTag("owned", "true")
+
+	node := field.AsField()
+
+	g.Expect(node.Tag.Value).To(Equal("`json:\"vnetRef\" group:\"microsoft.network\" owned:\"true\"`"))
+}
@@ -11,8 +11,9 @@ import (
 	"go/format"
 	"go/parser"
 	"go/token"
+	"io/ioutil"
 	"log"
-	"os"
+	"path"
 	"sort"
 )
 
@@ -56,7 +57,12 @@ func (file *FileDefinition) generateImportSpecs() []ast.Spec {
 
 	var requiredImports = make(map[PackageReference]bool) // fake set type
 	for _, s := range file.definitions {
-		for _, requiredImport := range s.Type().RequiredImports() {
+		definitionImports := s.Type().RequiredImports()
+		if hasImports, ok := s.(HasImports); ok {
+			definitionImports = hasImports.RequiredImports()
+		}
+
+		for _, requiredImport := range definitionImports {
 			// no need to import the current package
 			if requiredImport != file.PackageReference {
 				requiredImports[requiredImport] = true
@@ -65,12 +71,17 @@ func (file *FileDefinition) generateImportSpecs() []ast.Spec {
 	}
 
 	for requiredImport := range requiredImports {
+		// name the import if it is used under a name other than its own
+		var name *ast.Ident
+		if requiredImport.IsLibrary() && requiredImport.PackageName() != path.Base(requiredImport.PackagePath()) {
+			name = ast.NewIdent(requiredImport.PackageName())
+		}
+
 		importSpecs = append(importSpecs, &ast.ImportSpec{
-			Name: nil,
+			Name: name,
 			Path: &ast.BasicLit{
-				Kind: token.STRING,
-				// TODO: this will need adjusting in future:
-				Value: "\"github.com/Azure/k8s-infra/hack/generator/apis/" + requiredImport.PackagePath() + "\"",
+				Kind:  token.STRING,
+				Value: "\"" + requiredImport.ImportPath() + "\"",
 			},
 		})
 	}
@@ -91,14 +102,19 @@ func (file *FileDefinition) AsAst() ast.Node {
 		decls = append(decls, s.AsDeclarations()...)
 	}
 
-	// Emit struct registration for each resource:
+	// Emit struct registration for each resource and its list:
 	var exprs []ast.Expr
 	for _, defn := range file.definitions {
 		if structDefn, ok := defn.(*StructDefinition); ok && structDefn.IsResource() {
-			exprs = append(exprs, &ast.UnaryExpr{
-				Op: token.AND,
-				X:  &ast.CompositeLit{Type: structDefn.StructReference.AsType()},
-			})
+			exprs = append(exprs,
+				&ast.UnaryExpr{
+					Op: token.AND,
+					X:  &ast.CompositeLit{Type: structDefn.StructReference.AsType()},
+				},
+				&ast.UnaryExpr{
+					Op: token.AND,
+					X:  &ast.CompositeLit{Type: ast.NewIdent(structDefn.Name() + "List")},
+				})
 		}
 	}
 
@@ -123,7 +139,7 @@ func (file *FileDefinition) AsAst() ast.Node {
 	header, headerLen := createComments(
 		"Copyright (c) Microsoft Corporation.",
 		"Licensed under the MIT license.",
-		"This is a generated file. Please do not make manual changes.")
+		GeneratedFileHeader)
 
 	// We set Package (the offset of the package keyword) so that it follows the header comments
 	result := &ast.File{
@@ -154,32 +170,41 @@ func createComments(lines ...string) ([]*ast.Comment, int) {
 
 // SaveTo writes this generated file to disk
 func (file FileDefinition) SaveTo(filePath string) error {
+	content, err := file.Render()
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filePath, content, 0644)
+}
+
+// Render returns the formatted Go source of this generated file
+func (file FileDefinition) Render() ([]byte, error) {
 	original := file.AsAst()
 
 	// Write generated source into a memory buffer
 	fset := token.NewFileSet()
-	fset.AddFile(filePath, 1, 102400)
+	fset.AddFile("", 1, 102400)
 
 	var buffer bytes.Buffer
 	err := format.Node(&buffer, fset, original)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Parse it out of the buffer again so we can "go fmt" it
 	var toFormat ast.Node
-	toFormat, err = parser.ParseFile(fset, filePath, &buffer, parser.ParseComments)
+	toFormat, err = parser.ParseFile(fset, "", &buffer, parser.ParseComments)
 	if err != nil {
 		log.Printf("Failed to reformat code (%s); keeping code as is.", err)
 		toFormat = original
 	}
 
-	// Write it to a file
-	f, err := os.Create(filePath)
+	var result bytes.Buffer
+	err = format.Node(&result, fset, toFormat)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer f.Close()
 
-	return format.Node(f, fset, toFormat)
+	return result.Bytes(), nil
 }
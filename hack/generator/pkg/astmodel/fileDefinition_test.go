@@ -6,6 +6,9 @@
 package astmodel
 
 import (
+	"bytes"
+	"go/format"
+	"go/token"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -21,6 +24,35 @@ func Test_NewFileDefinition_GivenValues_InitializesFields(t *testing.T) {
 	g.Expect(file.definitions).To(HaveLen(1))
 }
 
+func Test_FileDefinitionAsAst_GivenResource_RegistersResourceAndList(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ref := NewStructReference("Person", "group", "2020-01-01", true)
+	person := NewStructDefinition(ref, NewFieldDefinition("fullName", "fullName", StringType))
+	file := NewFileDefinition(ref.PackageReference, person)
+
+	var buffer bytes.Buffer
+	err := format.Node(&buffer, token.NewFileSet(), file.AsAst())
+
+	g.Expect(err).To(BeNil())
+	g.Expect(buffer.String()).To(ContainSubstring("SchemeBuilder.Register(&Person{}, &PersonList{})"))
+}
+
+func Test_FileDefinitionAsAst_GivenResourceWithARMType_ImplementsMetaObject(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ref := NewStructReference("Person", "group", "2020-01-01", true)
+	person := NewStructDefinition(ref, NewFieldDefinition("fullName", "fullName", StringType)).WithARMType("Microsoft.Test/people")
+	file := NewFileDefinition(ref.PackageReference, person)
+
+	var buffer bytes.Buffer
+	err := format.Node(&buffer, token.NewFileSet(), file.AsAst())
+
+	g.Expect(err).To(BeNil())
+	g.Expect(buffer.String()).To(ContainSubstring("SchemeBuilder.Register(&Person{}, &PersonList{})"))
+	g.Expect(buffer.String()).To(ContainSubstring("func (*Person) ResourceType() string {\n\treturn \"Microsoft.Test/people\"\n}"))
+}
+
 func NewTestStruct(name string, fields ...string) StructDefinition {
 	var fs []*FieldDefinition
 	for _, n := range fields {
@@ -32,3 +64,20 @@ func NewTestStruct(name string, fields ...string) StructDefinition {
 
 	return *definition
 }
+
+func Test_FileDefinitionAsAst_GivenAliasedLibraryType_ImportsPackageUnderAlias(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	library := NewAliasedLibraryPackageReference("github.com/Azure/k8s-infra/apis/core/v1", "azcorev1")
+	reference := NewDefinitionName(library, "KnownTypeReference")
+	ref := NewStructReference("Person", "group", "2020-01-01", false)
+	person := NewStructDefinition(ref, NewFieldDefinition("Employer", "employer", &reference))
+	file := NewFileDefinition(ref.PackageReference, person)
+
+	var buffer bytes.Buffer
+	err := format.Node(&buffer, token.NewFileSet(), file.AsAst())
+
+	g.Expect(err).To(BeNil())
+	g.Expect(buffer.String()).To(ContainSubstring(`azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"`))
+	g.Expect(buffer.String()).To(ContainSubstring("Employer azcorev1.KnownTypeReference"))
+}
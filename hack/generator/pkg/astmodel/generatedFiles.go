@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import (
+	"bufio"
+	"log"
+	"os"
+	"strings"
+)
+
+// GeneratedFileHeader marks every file we generate, following the Go convention for generated code, so that
+// generated files can be told apart from hand-written files in the same package
+const GeneratedFileHeader = "Code generated by k8s-infra-gen. DO NOT EDIT."
+
+// IsGeneratedFile returns true if the given file carries the header of a file we generated
+func IsGeneratedFile(filePath string) (bool, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	// the header must come before the package clause
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "// "+GeneratedFileHeader {
+			return true, nil
+		}
+
+		if strings.HasPrefix(line, "package ") {
+			return false, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
+
+// canWriteGeneratedFile returns true if we may write the given file, which is the case unless it already exists and
+// was written by hand
+func canWriteGeneratedFile(filePath string) bool {
+	generated, err := IsGeneratedFile(filePath)
+	if os.IsNotExist(err) {
+		return true
+	}
+
+	if err != nil {
+		log.Printf("WRN Unable to check whether %s was generated, leaving it unchanged: %v\n", filePath, err)
+		return false
+	}
+
+	if !generated {
+		log.Printf("WRN Leaving hand-written file %s unchanged\n", filePath)
+	}
+
+	return generated
+}
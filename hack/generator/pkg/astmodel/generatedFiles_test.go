@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_IsGeneratedFile_GivenSavedFileDefinition_ReturnsTrue(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "generated")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	ref := NewStructReference("Person", "group", "2020-01-01", false)
+	filePath := filepath.Join(dir, "person_types.go")
+	g.Expect(NewFileDefinition(ref.PackageReference, NewStructDefinition(ref)).SaveTo(filePath)).To(Succeed())
+
+	g.Expect(IsGeneratedFile(filePath)).To(BeTrue())
+}
+
+func Test_IsGeneratedFile_GivenHandWrittenFile_ReturnsFalse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "generated")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "webhook.go")
+	content := "package v1\n\n// " + GeneratedFileHeader + "\n"
+	g.Expect(ioutil.WriteFile(filePath, []byte(content), 0600)).To(Succeed())
+
+	g.Expect(IsGeneratedFile(filePath)).To(BeFalse())
+}
+
+func Test_EmitDefinitions_GivenHandWrittenConversion_LeavesItUnchanged(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "generated")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	handWritten := []byte("package v20200101\n")
+	conversionFile := filepath.Join(dir, "conversion.go")
+	g.Expect(ioutil.WriteFile(conversionFile, handWritten, 0600)).To(Succeed())
+
+	ref := NewStructReference("Widget", "microsoft.test", "v20200101", true)
+	pkg := NewPackageDefinition(ref.PackageReference)
+	pkg.AddDefinition(NewStructDefinition(ref))
+	pkg.ConvertThrough(HubPackageReference(ref.PackageReference))
+	pkg.EmitDefinitions(dir)
+
+	g.Expect(ioutil.ReadFile(conversionFile)).To(Equal(handWritten))
+	g.Expect(IsGeneratedFile(filepath.Join(dir, "groupversion_info.go"))).To(BeTrue())
+	g.Expect(IsGeneratedFile(filepath.Join(dir, "Widget_types.go"))).To(BeTrue())
+}
+
+func Test_ImportPath_GivenGeneratedPackagesRoot_ReturnsPathBeneathRoot(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	SetGeneratedPackagesRoot("example.com/module/apis")
+	defer SetGeneratedPackagesRoot(DefaultGeneratedPackagesRoot)
+
+	ref := NewPackageReference("microsoft.test", "v20200101")
+
+	g.Expect(ref.ImportPath()).To(Equal("example.com/module/apis/microsoft.test/v20200101"))
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+// HubPackageName is the name of the unversioned package of each group, which holds the storage version of its
+// resources; every dated version converts to and from it
+const HubPackageName = "v1"
+
+// HubPackageReference returns the hub package of the group of the given package
+func HubPackageReference(ref PackageReference) PackageReference {
+	return NewPackageReference(ref.GroupName(), HubPackageName)
+}
+
+// CreateHubDefinitions merges all the API versions of each definition into a definition of the same name in the hub
+// package of its group. The fields of a struct are the union of the fields of every version, and the values of an enum
+// are the union of every version's. Resources are marked as the storage version.
+// Versions are converted through the hub by matching the JSON names of their fields, so an error is returned if two
+// versions disagree on the type of a field, rather than generating conversions which would fail at runtime.
+func CreateHubDefinitions(definitions []Definition) ([]Definition, error) {
+	// visit the oldest versions first, so that later versions take precedence
+	sorted := append(definitions[:0:0], definitions...)
+	sort.SliceStable(sorted, func(i int, j int) bool {
+		return sorted[i].Reference().PackageName() < sorted[j].Reference().PackageName()
+	})
+
+	hubs := make(map[DefinitionName]Definition)
+	var names []DefinitionName
+	for _, def := range sorted {
+		hubRef := HubPackageReference(def.Reference().PackageReference)
+		name := NewDefinitionName(hubRef, def.Reference().Name())
+
+		existing, seen := hubs[name]
+		if !seen {
+			names = append(names, name)
+		}
+
+		switch d := def.(type) {
+		case *StructDefinition:
+			merged, err := mergeStructDefinition(name, existing, d)
+			if err != nil {
+				return nil, err
+			}
+
+			hubs[name] = merged
+
+		case *EnumDefinition:
+			hubs[name] = mergeEnumDefinition(name, existing, d)
+
+		case *UnionDefinition:
+			union := rehomeType(&d.UnionType, hubRef).(*UnionType)
+			hubs[name] = &UnionDefinition{UnionType: *union}
+
+		default:
+			log.Printf("WRN Unable to include %T %s in hub version\n", def, name.Name())
+		}
+	}
+
+	var result []Definition
+	for _, name := range names {
+		if def, ok := hubs[name]; ok {
+			result = append(result, def)
+		}
+	}
+
+	return result, nil
+}
+
+func mergeStructDefinition(name DefinitionName, existing Definition, def *StructDefinition) (*StructDefinition, error) {
+	var fields []*FieldDefinition
+	fieldIndexes := make(map[string]int)
+	if existingStruct, ok := existing.(*StructDefinition); ok {
+		for i, field := range existingStruct.fields {
+			fields = append(fields, field)
+			fieldIndexes[fieldKey(field)] = i
+		}
+	}
+
+	for _, field := range def.fields {
+		hubField := *field
+		hubField.fieldType = rehomeType(field.fieldType, name.PackageReference)
+
+		key := fieldKey(&hubField)
+		if i, ok := fieldIndexes[key]; ok {
+			// compare by rendering, as the versions of an enum or union differ but refer to the same hub definition
+			existingType := typeAsString(fields[i].fieldType)
+			if newType := typeAsString(hubField.fieldType); existingType != newType {
+				return nil, fmt.Errorf(
+					"versions of %s disagree on the type of %s: %s has %s, but an earlier version has %s",
+					name.Name(),
+					key,
+					def.PackageName(),
+					newType,
+					existingType)
+			}
+
+			fields[i] = &hubField
+			continue
+		}
+
+		fieldIndexes[key] = len(fields)
+		fields = append(fields, &hubField)
+	}
+
+	ref := StructReference{DefinitionName: name, isResource: def.IsResource()}
+	result := NewStructDefinition(ref, fields...).WithDescription(&def.description).WithARMType(def.armType)
+	if result.IsResource() {
+		result = result.MarkAsStorageVersion()
+	}
+
+	return result, nil
+}
+
+// fieldKey identifies a field across versions; embedded fields have no name so are identified by their type
+func fieldKey(field *FieldDefinition) string {
+	if field.fieldName != "" {
+		return string(field.fieldName)
+	}
+
+	return typeAsString(field.fieldType)
+}
+
+func mergeEnumDefinition(name DefinitionName, existing Definition, def *EnumDefinition) *EnumDefinition {
+	var options []EnumValue
+	values := make(map[string]bool)
+	if existingEnum, ok := existing.(*EnumDefinition); ok {
+		for _, option := range existingEnum.Options {
+			options = append(options, option)
+			values[option.Value] = true
+		}
+	}
+
+	for _, option := range def.Options {
+		if !values[option.Value] {
+			options = append(options, option)
+			values[option.Value] = true
+		}
+	}
+
+	return &EnumDefinition{
+		EnumType: EnumType{
+			DefinitionName: name,
+			BaseType:       def.BaseType,
+			Options:        options,
+		},
+	}
+}
+
+// rehomeType returns the given type with every reference to a generated definition moved into the given package
+func rehomeType(t Type, pkg PackageReference) Type {
+	return mapDefinitionNames(t, func(name DefinitionName) DefinitionName {
+		if name.IsLibrary() {
+			return name
+		}
+
+		return NewDefinitionName(pkg, name.name)
+	})
+}
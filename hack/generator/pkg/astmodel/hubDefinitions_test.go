@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import (
+	"go/ast"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_CreateHubDefinitions_GivenVersionsOfResource_MergesFields(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	older := NewStructDefinition(
+		NewStructReference("Widget", "microsoft.test", "v20190101", true),
+		NewFieldDefinition("Name", "name", StringType),
+		NewFieldDefinition("Size", "size", StringType)).WithARMType("Microsoft.Test/widgets")
+	newer := NewStructDefinition(
+		NewStructReference("Widget", "microsoft.test", "v20200101", true),
+		NewFieldDefinition("Name", "name", StringType),
+		NewFieldDefinition("Size", "size", StringType),
+		NewFieldDefinition("Colour", "colour", StringType)).WithARMType("Microsoft.Test/widgets")
+
+	hubs, err := CreateHubDefinitions([]Definition{newer, older})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(hubs).To(HaveLen(1))
+	hub := hubs[0].(*StructDefinition)
+	g.Expect(hub.PackageReference).To(Equal(NewPackageReference("microsoft.test", HubPackageName)))
+	g.Expect(hub.IsStorageVersion()).To(BeTrue())
+	g.Expect(hub.ARMType()).To(Equal("Microsoft.Test/widgets"))
+	g.Expect(hub.fields).To(HaveLen(3))
+	g.Expect(hub.fields[2].fieldName).To(Equal(FieldName("Colour")))
+}
+
+func Test_CreateHubDefinitions_GivenVersionsDisagreeOnFieldType_ReturnsError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	older := NewStructDefinition(
+		NewStructReference("Widget", "microsoft.test", "v20190101", true),
+		NewFieldDefinition("Size", "size", StringType))
+	newer := NewStructDefinition(
+		NewStructReference("Widget", "microsoft.test", "v20200101", true),
+		NewFieldDefinition("Size", "size", IntType))
+
+	_, err := CreateHubDefinitions([]Definition{newer, older})
+
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("Widget"))
+	g.Expect(err.Error()).To(ContainSubstring("Size"))
+}
+
+func Test_CreateHubDefinitions_GivenReferenceToDefinition_ReferencesHubDefinition(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	properties := NewDefinitionName(NewPackageReference("microsoft.test", "v20200101"), "WidgetProperties")
+	widget := NewStructDefinition(
+		NewStructReference("Widget", "microsoft.test", "v20200101", true),
+		NewFieldDefinition("Properties", "properties", NewOptionalType(&properties)))
+
+	hubs, err := CreateHubDefinitions([]Definition{widget})
+	g.Expect(err).To(BeNil())
+
+	hub := hubs[0].(*StructDefinition)
+
+	hubProperties := NewDefinitionName(NewPackageReference("microsoft.test", HubPackageName), "WidgetProperties")
+	g.Expect(hub.fields[0].fieldType.Equals(NewOptionalType(&hubProperties))).To(BeTrue())
+}
+
+func Test_CreateHubDefinitions_GivenVersionsOfEnum_MergesValues(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	red := EnumValue{Identifier: "Red", Value: `"Red"`}
+	blue := EnumValue{Identifier: "Blue", Value: `"Blue"`}
+	older := &EnumDefinition{EnumType{
+		DefinitionName: NewDefinitionName(NewPackageReference("microsoft.test", "v20190101"), "Colour"),
+		BaseType:       StringType,
+		Options:        []EnumValue{red},
+	}}
+	newer := &EnumDefinition{EnumType{
+		DefinitionName: NewDefinitionName(NewPackageReference("microsoft.test", "v20200101"), "Colour"),
+		BaseType:       StringType,
+		Options:        []EnumValue{red, blue},
+	}}
+
+	hubs, err := CreateHubDefinitions([]Definition{older, newer})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(hubs).To(HaveLen(1))
+	g.Expect(hubs[0].(*EnumDefinition).Options).To(ConsistOf(red, blue))
+}
+
+func Test_StructDefinitionAsDeclarations_GivenStorageVersion_MarksHub(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ref := NewStructReference("Widget", "microsoft.test", HubPackageName, true)
+	declarations := NewStructDefinition(ref).MarkAsStorageVersion().AsDeclarations()
+
+	resource := declarations[2].(*ast.GenDecl)
+	g.Expect(resource.Doc.Text()).To(ContainSubstring("+kubebuilder:storageversion"))
+
+	hub := declarations[len(declarations)-1].(*ast.FuncDecl)
+	g.Expect(hub.Name.Name).To(Equal("Hub"))
+}
+
+func Test_RenderDefinitions_GivenHub_KeepsHubOnlyFieldsInConversionData(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ref := NewStructReference("Widget", "microsoft.test", "v20200101", true)
+	pkg := NewPackageDefinition(ref.PackageReference)
+	pkg.AddDefinition(NewStructDefinition(ref))
+	pkg.ConvertThrough(HubPackageReference(ref.PackageReference))
+
+	files, err := pkg.RenderDefinitions()
+	g.Expect(err).To(BeNil())
+
+	conversion := string(files["conversion.go"])
+	g.Expect(conversion).To(ContainSubstring("azcorev1.RestoreConversionData(src, dst)"))
+	g.Expect(conversion).To(ContainSubstring("azcorev1.RemoveConversionData(dst)"))
+	g.Expect(conversion).To(ContainSubstring("azcorev1.StoreConversionData(src, dst)"))
+}
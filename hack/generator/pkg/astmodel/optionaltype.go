@@ -22,6 +22,9 @@ func NewOptionalType(element Type) *OptionalType {
 // assert we implemented Type correctly
 var _ Type = (*OptionalType)(nil)
 
+// assert we implemented HasRelatedDefinitions correctly
+var _ HasRelatedDefinitions = (*OptionalType)(nil)
+
 // AsType renders the Go abstract syntax tree for an optional type
 func (optional *OptionalType) AsType() ast.Expr {
 	return &ast.StarExpr{
@@ -51,3 +54,12 @@ func (optional *OptionalType) Equals(t Type) bool {
 
 	return false
 }
+
+// RelatedDefinitions implements the HasRelatedDefinitions interface for OptionalType
+func (optional *OptionalType) RelatedDefinitions(ref PackageReference, namehint string, idFactory IdentifierFactory) []Definition {
+	if df, ok := optional.element.(HasRelatedDefinitions); ok {
+		return df.RelatedDefinitions(ref, namehint, idFactory)
+	}
+
+	return nil
+}
@@ -7,9 +7,11 @@ package astmodel
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"path/filepath"
+	"sort"
 	"text/template"
 )
 
@@ -18,11 +20,19 @@ type PackageDefinition struct {
 	PackageReference
 
 	definitions []Definition
+
+	// hub is the package the resources of this package convert to and from, if any
+	hub *PackageReference
 }
 
 // NewPackageDefinition creates a new PackageDefinition
 func NewPackageDefinition(reference PackageReference) *PackageDefinition {
-	return &PackageDefinition{reference, nil}
+	return &PackageDefinition{reference, nil, nil}
+}
+
+// ConvertThrough records that the resources of this package convert to and from the given hub package
+func (pkgDef *PackageDefinition) ConvertThrough(hub PackageReference) {
+	pkgDef.hub = &hub
 }
 
 // AddDefinition adds a Definition to the PackageDefinition
@@ -30,8 +40,32 @@ func (pkgDef *PackageDefinition) AddDefinition(def Definition) {
 	pkgDef.definitions = append(pkgDef.definitions, def)
 }
 
-// EmitDefinitions emits the PackageDefinition to an output directory
-func (pkgDef *PackageDefinition) EmitDefinitions(outputDir string) {
+// EmitDefinitions emits the PackageDefinition to an output directory, leaving any hand-written files there unchanged
+func (pkgDef *PackageDefinition) EmitDefinitions(outputDir string) error {
+	files, err := pkgDef.RenderDefinitions()
+	if err != nil {
+		return err
+	}
+
+	for fileName, content := range files {
+		outputFile := filepath.Join(outputDir, fileName)
+		if !canWriteGeneratedFile(outputFile) {
+			continue
+		}
+
+		log.Printf("Writing '%s'\n", outputFile)
+		err = ioutil.WriteFile(outputFile, content, 0644)
+		if err != nil {
+			return fmt.Errorf("writing %s with: %w", outputFile, err)
+		}
+	}
+
+	return nil
+}
+
+// RenderDefinitions generates the files of the PackageDefinition without writing them, returning the content of each
+// file keyed by its name
+func (pkgDef *PackageDefinition) RenderDefinitions() (map[string][]byte, error) {
 
 	resources, otherDefinitions := partitionDefinitions(pkgDef.definitions)
 
@@ -42,18 +76,46 @@ func (pkgDef *PackageDefinition) EmitDefinitions(outputDir string) {
 	}
 
 	allocateTypesToFiles(otherDefinitions, filesToGenerate)
-	emitFiles(filesToGenerate, outputDir)
-	emitGroupVersionFile(pkgDef, outputDir)
-}
 
-func emitFiles(filesToGenerate map[string][]Definition, outputDir string) {
+	result := make(map[string][]byte)
 	for fileName, defs := range filesToGenerate {
 		genFile := NewFileDefinition(defs[0].Reference().PackageReference, defs...)
-		outputFile := filepath.Join(outputDir, fileName+"_types.go")
-		log.Printf("Writing '%s'\n", outputFile)
 		genFile.Tidy()
-		genFile.SaveTo(outputFile)
+		content, err := genFile.Render()
+		if err != nil {
+			return nil, fmt.Errorf("generating %s with: %w", fileName, err)
+		}
+
+		result[fileName+"_types.go"] = content
+	}
+
+	content, err := renderTemplate(groupVersionFileTemplate, pkgDef)
+	if err != nil {
+		return nil, fmt.Errorf("generating group version file of %s with: %w", pkgDef.PackagePath(), err)
 	}
+
+	result["groupversion_info.go"] = content
+
+	if pkgDef.hub != nil && len(resources) > 0 {
+		content, err := renderConversionFile(pkgDef, resources)
+		if err != nil {
+			return nil, fmt.Errorf("generating conversion file of %s with: %w", pkgDef.PackagePath(), err)
+		}
+
+		result["conversion.go"] = content
+	}
+
+	return result, nil
+}
+
+// renderTemplate executes the given template, returning the content generated
+func renderTemplate(t *template.Template, data interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, data); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
 }
 
 func anyReferences(defs []Definition, t Type) bool {
@@ -182,6 +244,8 @@ Copyright (c) Microsoft Corporation.
 Licensed under the MIT license.
 */
 
+// ` + GeneratedFileHeader + `
+
 // Package {{.PackageName}} contains API Schema definitions for the {{.GroupName}} {{.PackageName}} API group
 // +kubebuilder:object:generate=true
 // All object properties are optional by default, this will be overridden when needed:
@@ -207,11 +271,92 @@ var (
 	localSchemeBuilder = SchemeBuilder.SchemeBuilder
 )`))
 
-func emitGroupVersionFile(pkgDef *PackageDefinition, outputDir string) {
-	buf := &bytes.Buffer{}
-	groupVersionFileTemplate.Execute(buf, pkgDef)
+var conversionFileTemplate = template.Must(template.New("conversionFile").Parse(`
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// ` + GeneratedFileHeader + `
+
+package {{.PackageName}}
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	{{.Hub.PackageName}} "{{.Hub.ImportPath}}"
+)
+{{range .Resources}}
+// ConvertTo converts this {{.}} to the hub version, restoring the fields of the hub this version can not represent
+func (src *{{.}}) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*{{$.Hub.PackageName}}.{{.}})
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := azcorev1.RestoreConversionData(src, dst); err != nil {
+		return fmt.Errorf("restoring conversion data of {{.}} %s with: %w", src.Name, err)
+	}
+
+	if err := convertThroughJSON(src.Spec, &dst.Spec); err != nil {
+		return fmt.Errorf("converting spec of {{.}} %s to the hub version with: %w", src.Name, err)
+	}
+
+	if err := convertThroughJSON(src.Status, &dst.Status); err != nil {
+		return fmt.Errorf("converting status of {{.}} %s to the hub version with: %w", src.Name, err)
+	}
+
+	azcorev1.RemoveConversionData(dst)
+	return nil
+}
+
+// ConvertFrom converts the hub version to this {{.}}, storing the fields of the hub this version can not represent
+func (dst *{{.}}) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*{{$.Hub.PackageName}}.{{.}})
+	dst.ObjectMeta = src.ObjectMeta
+
+	if err := convertThroughJSON(src.Spec, &dst.Spec); err != nil {
+		return fmt.Errorf("converting spec of {{.}} %s from the hub version with: %w", src.Name, err)
+	}
+
+	if err := convertThroughJSON(src.Status, &dst.Status); err != nil {
+		return fmt.Errorf("converting status of {{.}} %s from the hub version with: %w", src.Name, err)
+	}
+
+	if err := azcorev1.StoreConversionData(src, dst); err != nil {
+		return fmt.Errorf("storing conversion data of {{.}} %s with: %w", src.Name, err)
+	}
+
+	return nil
+}
+{{end}}
+// convertThroughJSON copies each field of src to the field of dst with the same JSON name. The hub version has every
+// field of every version, with the same type in each as generation fails if versions disagree, so nothing is lost
+// converting to it; the fields this version lacks are kept in the conversion data of the object when converting from
+// it, and restored when converting back.
+func convertThroughJSON(src interface{}, dst interface{}) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
+`))
+
+func renderConversionFile(pkgDef *PackageDefinition, resources []*StructDefinition) ([]byte, error) {
+	var names []string
+	for _, resource := range resources {
+		names = append(names, resource.Name())
+	}
+
+	sort.Strings(names)
 
-	gvFile := filepath.Join(outputDir, "groupversion_info.go")
-	// TODO[dj]: handle this error
-	ioutil.WriteFile(gvFile, buf.Bytes(), 0700)
+	return renderTemplate(conversionFileTemplate, struct {
+		PackageName string
+		Hub         *PackageReference
+		Resources   []string
+	}{pkgDef.PackageName(), pkgDef.hub, names})
 }
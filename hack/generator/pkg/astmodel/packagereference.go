@@ -5,20 +5,71 @@
 
 package astmodel
 
-import "path/filepath"
+import (
+	"path"
+	"path/filepath"
+)
+
+// DefaultGeneratedPackagesRoot is the import path of the directory the generated packages are written to, unless
+// configured otherwise
+const DefaultGeneratedPackagesRoot = "github.com/Azure/k8s-infra/hack/generator/apis"
+
+var generatedPackagesRoot = DefaultGeneratedPackagesRoot
+
+// SetGeneratedPackagesRoot sets the import path of the directory the generated packages are written to, which is
+// used when generated packages import each other
+func SetGeneratedPackagesRoot(root string) {
+	generatedPackagesRoot = root
+}
 
 // PackageReference indicates which package
 // a struct belongs to.
 type PackageReference struct {
 	groupName   string
 	packageName string
+	// libraryPath is the import path of a package we don't generate, such as one from the standard library
+	libraryPath string
+}
+
+// NewPackageReference creates a reference to the generated package of the given group and version
+func NewPackageReference(groupName string, packageName string) PackageReference {
+	return PackageReference{groupName: groupName, packageName: packageName}
+}
+
+// NewLibraryPackageReference creates a reference to a package we don't generate, given its import path
+func NewLibraryPackageReference(path string) PackageReference {
+	return PackageReference{packageName: filepath.Base(path), libraryPath: path}
+}
+
+// NewAliasedLibraryPackageReference creates a reference to a package we don't generate, given its import path and the
+// name to import it as
+func NewAliasedLibraryPackageReference(path string, alias string) PackageReference {
+	return PackageReference{packageName: alias, libraryPath: path}
+}
+
+// IsLibrary returns true if the package is not one we generate
+func (pr *PackageReference) IsLibrary() bool {
+	return pr.libraryPath != ""
 }
 
 // PackagePath is the path to the package reference
 func (pr *PackageReference) PackagePath() string {
+	if pr.IsLibrary() {
+		return pr.libraryPath
+	}
+
 	return filepath.Join(pr.GroupName(), pr.PackageName())
 }
 
+// ImportPath is the path used to import the referenced package
+func (pr *PackageReference) ImportPath() string {
+	if pr.IsLibrary() {
+		return pr.libraryPath
+	}
+
+	return path.Join(generatedPackagesRoot, pr.GroupName(), pr.PackageName())
+}
+
 // GroupName is the group name of the package reference
 func (pr *PackageReference) GroupName() string {
 	return pr.groupName
@@ -31,5 +82,5 @@ func (pr *PackageReference) PackageName() string {
 
 // Equals returns true if the passed package reference references the same package, false otherwise
 func (pr *PackageReference) Equals(ref *PackageReference) bool {
-	return pr.groupName == ref.groupName && pr.packageName == pr.packageName
+	return pr.groupName == ref.groupName && pr.packageName == pr.packageName && pr.libraryPath == ref.libraryPath
 }
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import "log"
+
+// RenameDefinitions returns the given definitions with each definition named in renames given its new name, and
+// every reference to a renamed definition updated to match
+func RenameDefinitions(definitions []Definition, renames map[DefinitionName]string) []Definition {
+	rename := func(name DefinitionName) DefinitionName {
+		if newName, ok := renames[name]; ok {
+			return NewDefinitionName(name.PackageReference, newName)
+		}
+
+		return name
+	}
+
+	var result []Definition
+	for _, def := range definitions {
+		switch d := def.(type) {
+		case *StructDefinition:
+			renamed := *d
+			renamed.DefinitionName = rename(d.DefinitionName)
+			renamed.StructType = *mapDefinitionNames(&d.StructType, rename).(*StructType)
+			result = append(result, &renamed)
+
+		case *EnumDefinition:
+			renamed := *d
+			renamed.DefinitionName = rename(d.DefinitionName)
+			result = append(result, &renamed)
+
+		case *UnionDefinition:
+			union := mapDefinitionNames(&d.UnionType, rename).(*UnionType)
+			result = append(result, &UnionDefinition{UnionType: *union})
+
+		default:
+			log.Printf("WRN Unable to rename references within %T %s\n", def, def.Reference().Name())
+			result = append(result, def)
+		}
+	}
+
+	return result
+}
+
+// mapDefinitionNames returns the given type with every reference to a definition replaced by the result of rename
+func mapDefinitionNames(t Type, rename func(DefinitionName) DefinitionName) Type {
+	switch it := t.(type) {
+	case *PrimitiveType:
+		return it
+
+	case *DefinitionName:
+		result := rename(*it)
+		return &result
+
+	case *StructReference:
+		result := *it
+		result.DefinitionName = rename(it.DefinitionName)
+		return &result
+
+	case *OptionalType:
+		return NewOptionalType(mapDefinitionNames(it.element, rename))
+
+	case *ArrayType:
+		return NewArrayType(mapDefinitionNames(it.element, rename))
+
+	case *MapType:
+		return NewMap(mapDefinitionNames(it.key, rename), mapDefinitionNames(it.value, rename))
+
+	case *StructType:
+		var fields []*FieldDefinition
+		for _, field := range it.fields {
+			f := *field
+			f.fieldType = mapDefinitionNames(field.fieldType, rename)
+			fields = append(fields, &f)
+		}
+
+		return NewStructType(fields...)
+
+	case *EnumType:
+		result := *it
+		result.DefinitionName = rename(it.DefinitionName)
+		return &result
+
+	case *UnionType:
+		result := *it
+		result.DefinitionName = rename(it.DefinitionName)
+		result.Alternatives = nil
+		for _, alternative := range it.Alternatives {
+			alternative.Type = mapDefinitionNames(alternative.Type, rename)
+			result.Alternatives = append(result.Alternatives, alternative)
+		}
+
+		return &result
+
+	default:
+		log.Printf("WRN Unable to rename definitions referenced by %T\n", t)
+		return t
+	}
+}
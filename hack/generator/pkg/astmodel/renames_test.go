@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_RenameDefinitions_GivenRenamedDefinition_UpdatesReferences(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	pkg := NewPackageReference("microsoft.test", "v20200101")
+	properties := NewDefinitionName(pkg, "WidgetPropertiesFormat")
+	widget := NewStructDefinition(
+		NewStructReference("Widget", "microsoft.test", "v20200101", true),
+		NewFieldDefinition("Properties", "properties", NewOptionalType(&properties)))
+	propertiesDef := NewStructDefinition(StructReference{DefinitionName: properties})
+
+	renamed := RenameDefinitions(
+		[]Definition{widget, propertiesDef},
+		map[DefinitionName]string{properties: "WidgetProperties"})
+
+	g.Expect(renamed).To(HaveLen(2))
+
+	renamedWidget := renamed[0].(*StructDefinition)
+	g.Expect(renamedWidget.Name()).To(Equal("Widget"))
+	g.Expect(renamedWidget.IsResource()).To(BeTrue())
+
+	expected := NewDefinitionName(pkg, "WidgetProperties")
+	g.Expect(renamedWidget.fields[0].fieldType.Equals(NewOptionalType(&expected))).To(BeTrue())
+	g.Expect(renamed[1].Reference().Name()).To(Equal("WidgetProperties"))
+}
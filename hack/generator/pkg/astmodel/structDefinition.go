@@ -9,6 +9,7 @@ import (
 	"go/ast"
 	"go/token"
 	"sort"
+	"strconv"
 )
 
 // StructReference is the (versioned) name of a struct
@@ -21,7 +22,7 @@ type StructReference struct {
 // NewStructReference creates a new StructReference
 // TODO[dj]: any "New" func should return a ptr
 func NewStructReference(name string, group string, version string, isResource bool) StructReference {
-	return StructReference{DefinitionName{PackageReference{group, version}, name}, isResource}
+	return StructReference{DefinitionName{PackageReference{groupName: group, packageName: version}, name}, isResource}
 }
 
 // IsResource indicates that the struct is an Azure resource
@@ -35,6 +36,12 @@ type StructDefinition struct {
 	StructType
 
 	description string
+
+	// armType is the type of the Azure resource, such as Microsoft.Network/virtualNetworks, if the struct is a resource
+	armType string
+
+	// isStorageVersion indicates the resource is the hub version that all other versions convert through
+	isStorageVersion bool
 }
 
 // Ensure StructDefinition implements Definition interface correctly
@@ -55,7 +62,7 @@ func (definition *StructDefinition) Type() Type {
 
 // NewStructDefinition is a factory method for creating a new StructDefinition
 func NewStructDefinition(ref StructReference, fields ...*FieldDefinition) *StructDefinition {
-	return &StructDefinition{ref, StructType{fields}, ""}
+	return &StructDefinition{ref, StructType{fields}, "", "", false}
 }
 
 // WithDescription adds a description (doc-comment) to the struct
@@ -69,6 +76,38 @@ func (definition *StructDefinition) WithDescription(description *string) *Struct
 	return &result
 }
 
+// WithARMType sets the type of the Azure resource the struct represents
+func (definition *StructDefinition) WithARMType(armType string) *StructDefinition {
+	result := *definition
+	result.armType = armType
+	return &result
+}
+
+// ARMType returns the type of the Azure resource the struct represents, or "" if it is not known
+func (definition *StructDefinition) ARMType() string {
+	return definition.armType
+}
+
+// MarkAsStorageVersion marks the resource as the version stored by Kubernetes, which all other versions convert
+// through
+func (definition *StructDefinition) MarkAsStorageVersion() *StructDefinition {
+	result := *definition
+	result.isStorageVersion = true
+	return &result
+}
+
+// IsStorageVersion indicates whether the resource is the version stored by Kubernetes
+func (definition *StructDefinition) IsStorageVersion() bool {
+	return definition.isStorageVersion
+}
+
+// WithFields returns a new StructDefinition with the specified fields in place of its own
+func (definition *StructDefinition) WithFields(fields ...*FieldDefinition) *StructDefinition {
+	result := *definition
+	result.StructType = StructType{fields}
+	return &result
+}
+
 // Field provides indexed access to our fields
 func (definition *StructDefinition) Field(index int) FieldDefinition {
 	return *definition.fields[index]
@@ -131,41 +170,111 @@ func (definition *StructDefinition) AsDeclarations() []ast.Decl {
 	declarations := []ast.Decl{declaration}
 
 	if definition.IsResource() {
-		resourceIdentifier := ast.NewIdent(definition.name)
-
-		/*
-			start off with:
-				metav1.TypeMeta   `json:",inline"`
-				metav1.ObjectMeta `json:"metadata,omitempty"`
-
-			then the Spec field
-		*/
-		resourceTypeSpec := &ast.TypeSpec{
-			Name: resourceIdentifier,
-			Type: &ast.StructType{
-				Fields: &ast.FieldList{
-					List: []*ast.Field{
-						typeMetaField,
-						objectMetaField,
-						defineField("Spec", identifier.Name, "`json:\"spec,omitempty\"`"),
+		declarations = append(declarations, definition.resourceDeclarations(identifier.Name)...)
+	}
+
+	return declarations
+}
+
+// resourceDeclarations generates the Status, resource and List types that accompany the Spec of a resource, giving
+// the same shape as the hand written custom resources
+func (definition *StructDefinition) resourceDeclarations(specName string) []ast.Decl {
+	statusName := definition.name + "Status"
+	listName := definition.name + "List"
+
+	/*
+		the Status type records what the operator has observed of the Azure resource:
+			ID                string `json:"id,omitempty"`
+			DeploymentID      string `json:"deploymentId,omitempty"`
+			ProvisioningState string `json:"provisioningState,omitempty"`
+	*/
+	statusDeclaration := defineStruct(
+		statusName,
+		[]string{"// " + statusName + " defines the observed state of " + definition.name + "\n"},
+		defineField("ID", "string", "`json:\"id,omitempty\"`"),
+		defineField("DeploymentID", "string", "`json:\"deploymentId,omitempty\"`"),
+		defineField("ProvisioningState", "string", "`json:\"provisioningState,omitempty\"`"))
+
+	/*
+		start off with:
+			metav1.TypeMeta   `json:",inline"`
+			metav1.ObjectMeta `json:"metadata,omitempty"`
+
+		then the Spec and Status fields
+	*/
+	resourceMarkers := []string{
+		"// +kubebuilder:object:root=true\n",
+		"// +kubebuilder:subresource:status\n",
+	}
+
+	if definition.isStorageVersion {
+		resourceMarkers = append(resourceMarkers, "// +kubebuilder:storageversion\n")
+	}
+
+	resourceDeclaration := defineStruct(
+		definition.name,
+		resourceMarkers,
+		typeMetaField,
+		objectMetaField,
+		defineField("Spec", specName, "`json:\"spec,omitempty\"`"),
+		defineField("Status", statusName, "`json:\"status,omitempty\"`"))
+
+	listDeclaration := defineStruct(
+		listName,
+		[]string{"// +kubebuilder:object:root=true\n"},
+		typeMetaField,
+		listMetaField,
+		defineField("Items", "[]"+definition.name, "`json:\"items\"`"))
+
+	declarations := []ast.Decl{statusDeclaration, resourceDeclaration, listDeclaration}
+
+	if definition.armType != "" {
+		// func (*<Name>) ResourceType() string { return "<ARM type>" }
+		// implements azcorev1.MetaObject, so the generic controller can reconcile the resource
+		resourceType := &ast.FuncDecl{
+			Doc: &ast.CommentGroup{
+				List: []*ast.Comment{
+					{Text: "\n// ResourceType returns the type of the Azure resource " + definition.name + " represents\n"},
+				},
+			},
+			Recv: &ast.FieldList{
+				List: []*ast.Field{{Type: &ast.StarExpr{X: ast.NewIdent(definition.name)}}},
+			},
+			Name: ast.NewIdent("ResourceType"),
+			Type: &ast.FuncType{
+				Params:  &ast.FieldList{},
+				Results: &ast.FieldList{List: []*ast.Field{{Type: ast.NewIdent("string")}}},
+			},
+			Body: &ast.BlockStmt{
+				List: []ast.Stmt{
+					&ast.ReturnStmt{
+						Results: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(definition.armType)}},
 					},
 				},
 			},
 		}
 
-		resourceDeclaration := &ast.GenDecl{
-			Tok:   token.TYPE,
-			Specs: []ast.Spec{resourceTypeSpec},
+		declarations = append(declarations, resourceType)
+	}
+
+	if definition.isStorageVersion {
+		// func (*<Name>) Hub() {}
+		// marks the resource as the conversion hub for controller-runtime
+		hub := &ast.FuncDecl{
 			Doc: &ast.CommentGroup{
 				List: []*ast.Comment{
-					{
-						Text: "// +kubebuilder:object:root=true\n",
-					},
+					{Text: "\n// Hub marks " + definition.name + " as the version all others convert through\n"},
 				},
 			},
+			Recv: &ast.FieldList{
+				List: []*ast.Field{{Type: &ast.StarExpr{X: ast.NewIdent(definition.name)}}},
+			},
+			Name: ast.NewIdent("Hub"),
+			Type: &ast.FuncType{Params: &ast.FieldList{}},
+			Body: &ast.BlockStmt{},
 		}
 
-		declarations = append(declarations, resourceDeclaration)
+		declarations = append(declarations, hub)
 	}
 
 	return declarations
@@ -192,6 +301,27 @@ func defineField(fieldName string, typeName string, tag string) *ast.Field {
 	return result
 }
 
+func defineStruct(name string, comments []string, fields ...*ast.Field) *ast.GenDecl {
+	doc := &ast.CommentGroup{}
+	for _, c := range comments {
+		doc.List = append(doc.List, &ast.Comment{Text: c})
+	}
+
+	return &ast.GenDecl{
+		Tok: token.TYPE,
+		Doc: doc,
+		Specs: []ast.Spec{
+			&ast.TypeSpec{
+				Name: ast.NewIdent(name),
+				Type: &ast.StructType{
+					Fields: &ast.FieldList{List: fields},
+				},
+			},
+		},
+	}
+}
+
 // TODO: metav1 import should be added via RequiredImports?
 var typeMetaField = defineField("", "metav1.TypeMeta", "`json:\",inline\"`")
 var objectMetaField = defineField("", "metav1.ObjectMeta", "`json:\"metadata,omitempty\"`")
+var listMetaField = defineField("", "metav1.ListMeta", "`json:\"metadata,omitempty\"`")
@@ -6,6 +6,7 @@
 package astmodel
 
 import (
+	"go/ast"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -40,6 +41,33 @@ func Test_StructDefinitionAsAst_GivenValidStruct_ReturnsNonNilResult(t *testing.
 	g.Expect(node).NotTo(BeNil())
 }
 
+func Test_StructDefinitionAsDeclarations_GivenResource_ReturnsSpecStatusResourceAndList(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ref := NewStructReference("Person", "group", "2020-01-01", true)
+	definition := NewStructDefinition(ref, createStringField("fullName", "Full legal name"))
+	declarations := definition.AsDeclarations()
+
+	var names []string
+	for _, decl := range declarations {
+		spec := decl.(*ast.GenDecl).Specs[0].(*ast.TypeSpec)
+		names = append(names, spec.Name.Name)
+	}
+
+	g.Expect(names).To(Equal([]string{"PersonSpec", "PersonStatus", "Person", "PersonList"}))
+}
+
+func Test_StructDefinitionAsDeclarations_GivenResource_MarksStatusSubresource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ref := NewStructReference("Person", "group", "2020-01-01", true)
+	definition := NewStructDefinition(ref)
+	resource := definition.AsDeclarations()[2].(*ast.GenDecl)
+
+	g.Expect(resource.Doc.Text()).To(ContainSubstring("+kubebuilder:object:root=true"))
+	g.Expect(resource.Doc.Text()).To(ContainSubstring("+kubebuilder:subresource:status"))
+}
+
 func createStringField(name string, description string) *FieldDefinition {
 	return NewFieldDefinition(FieldName(name), name, StringType).WithDescription(&description)
 }
@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"text/template"
+)
+
+// UnionDefinition generates the full definition of a union: a struct with one optional field per alternative, along
+// with the JSON marshalling which reads and writes whichever alternative is present
+type UnionDefinition struct {
+	UnionType
+}
+
+var _ Definition = (*UnionDefinition)(nil)
+
+var _ HasImports = (*UnionDefinition)(nil)
+
+// FileNameHint returns a desired name for this union if it goes into a standalone file
+func (union *UnionDefinition) FileNameHint() string {
+	return union.name
+}
+
+// Reference returns the unique name to use for specifying this union
+func (union *UnionDefinition) Reference() *DefinitionName {
+	return &union.DefinitionName
+}
+
+// Type returns a struct type of the alternatives, so that the types they reference are discoverable
+func (union *UnionDefinition) Type() Type {
+	var fields []*FieldDefinition
+	for _, alternative := range union.Alternatives {
+		fields = append(fields, NewFieldDefinition(alternative.FieldName, "-", alternative.Type))
+	}
+
+	return NewStructType(fields...)
+}
+
+// RequiredImports returns the packages of the alternatives, plus those used by the JSON marshalling
+func (union *UnionDefinition) RequiredImports() []PackageReference {
+	result := union.Type().RequiredImports()
+	result = append(result, NewLibraryPackageReference("encoding/json"), NewLibraryPackageReference("fmt"))
+	if union.Discriminator == "" {
+		result = append(result, NewLibraryPackageReference("bytes"))
+	}
+
+	return result
+}
+
+// Tidy does nothing, as the order of the alternatives determines the order in which they are tried when unmarshalling
+func (union *UnionDefinition) Tidy() {
+}
+
+// AsDeclarations generates the Go code representing this definition
+func (union *UnionDefinition) AsDeclarations() []ast.Decl {
+	var fields []*ast.Field
+	for _, alternative := range union.Alternatives {
+		fields = append(fields, &ast.Field{
+			Names: []*ast.Ident{ast.NewIdent(string(alternative.FieldName))},
+			Type:  &ast.StarExpr{X: alternative.Type.AsType()},
+			Tag:   &ast.BasicLit{Kind: token.STRING, Value: "`json:\"-\"`"},
+		})
+	}
+
+	// the alternatives are not fields of the JSON, so the schema of the union is left open; otherwise the API server
+	// would prune the value, as the struct has no properties
+	schemaType := `""`
+	if union.isObject() {
+		schemaType = "object"
+	}
+
+	declaration := &ast.GenDecl{
+		Tok: token.TYPE,
+		Doc: &ast.CommentGroup{
+			List: []*ast.Comment{
+				{Text: "\n// " + union.name + " holds exactly one of its alternatives\n"},
+				{Text: "// +kubebuilder:validation:Type=" + schemaType + "\n"},
+				{Text: "// +kubebuilder:validation:XPreserveUnknownFields\n"},
+			},
+		},
+		Specs: []ast.Spec{
+			&ast.TypeSpec{
+				Name: ast.NewIdent(union.name),
+				Type: &ast.StructType{Fields: &ast.FieldList{List: fields}},
+			},
+		},
+	}
+
+	return append([]ast.Decl{declaration}, union.marshallingDeclarations()...)
+}
+
+// isObject indicates whether the JSON of the union is always an object, which it is if the alternatives share a
+// discriminator property or are all objects themselves
+func (union *UnionDefinition) isObject() bool {
+	if union.Discriminator != "" {
+		return true
+	}
+
+	for _, alternative := range union.Alternatives {
+		switch alternative.Type.(type) {
+		case *StructType, *MapType, *DefinitionName:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+type unionTemplateAlternative struct {
+	FieldName          FieldName
+	TypeName           string
+	DiscriminatorValue string
+}
+
+var unionMarshallingTemplate = template.Must(template.New("unionMarshalling").Parse(`
+package union
+
+// MarshalJSON writes whichever alternative of the {{.Name}} is set
+func (u {{.Name}}) MarshalJSON() ([]byte, error) {
+{{- range .Alternatives}}
+	if u.{{.FieldName}} != nil {
+		return json.Marshal(u.{{.FieldName}})
+	}
+{{end}}
+	return []byte("null"), nil
+}
+
+{{if .Discriminator -}}
+// UnmarshalJSON reads the alternative of the {{.Name}} selected by its {{.Discriminator}}
+func (u *{{.Name}}) UnmarshalJSON(data []byte) error {
+	var discriminator struct {
+		Value string ` + "`" + `json:"{{.Discriminator}}"` + "`" + `
+	}
+
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return err
+	}
+
+	switch discriminator.Value {
+{{- range .Alternatives}}
+	case {{.DiscriminatorValue}}:
+		u.{{.FieldName}} = new({{.TypeName}})
+		return json.Unmarshal(data, u.{{.FieldName}})
+{{- end}}
+	default:
+		return fmt.Errorf("unknown {{.Discriminator}} %q for {{.Name}}", discriminator.Value)
+	}
+}
+{{- else -}}
+// UnmarshalJSON reads the first alternative of the {{.Name}} which data matches exactly
+func (u *{{.Name}}) UnmarshalJSON(data []byte) error {
+	strictDecoder := func() *json.Decoder {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		return decoder
+	}
+{{range .Alternatives}}
+	if value := new({{.TypeName}}); strictDecoder().Decode(value) == nil {
+		u.{{.FieldName}} = value
+		return nil
+	}
+{{end}}
+	return fmt.Errorf("value does not match any alternative of {{.Name}}")
+}
+{{- end}}
+`))
+
+// marshallingDeclarations generates MarshalJSON, which writes whichever alternative is set, and UnmarshalJSON, which
+// selects the alternative using the discriminator if there is one, or tries each alternative in turn if not
+func (union *UnionDefinition) marshallingDeclarations() []ast.Decl {
+	var alternatives []unionTemplateAlternative
+	for _, alternative := range union.Alternatives {
+		alternatives = append(alternatives, unionTemplateAlternative{
+			FieldName:          alternative.FieldName,
+			TypeName:           typeAsString(alternative.Type),
+			DiscriminatorValue: alternative.DiscriminatorValue,
+		})
+	}
+
+	buf := &bytes.Buffer{}
+	err := unionMarshallingTemplate.Execute(buf, struct {
+		Name          string
+		Discriminator string
+		Alternatives  []unionTemplateAlternative
+	}{union.name, union.Discriminator, alternatives})
+	if err != nil {
+		panic(err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "", buf, parser.ParseComments)
+	if err != nil {
+		panic(err)
+	}
+
+	// the parsed positions mean nothing in the file we are generating, and would misplace
+	// comments when printed, so discard them along with the position-dependent comment layout
+	for _, decl := range file.Decls {
+		clearPositions(decl)
+		for _, comment := range decl.(*ast.FuncDecl).Doc.List {
+			comment.Text = "\n" + comment.Text + "\n"
+		}
+	}
+
+	return file.Decls
+}
+
+// clearPositions resets every position within the given node
+func clearPositions(node ast.Node) {
+	positionType := reflect.TypeOf(token.NoPos)
+	ast.Inspect(node, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+
+		value := reflect.ValueOf(n).Elem()
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Field(i); field.Type() == positionType {
+				field.Set(reflect.ValueOf(token.NoPos))
+			}
+		}
+
+		if decl, ok := n.(*ast.FuncDecl); ok && decl.Doc != nil {
+			for _, comment := range decl.Doc.List {
+				comment.Slash = token.NoPos
+			}
+		}
+
+		return true
+	})
+}
+
+// typeAsString renders the Go source of the given type
+func typeAsString(t Type) string {
+	buf := &bytes.Buffer{}
+	if err := format.Node(buf, token.NewFileSet(), t.AsType()); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import "go/ast"
+
+// UnionType represents a value which is exactly one of a set of alternative types, as described by a JSON schema
+// oneOf or anyOf
+type UnionType struct {
+	DefinitionName
+	// Discriminator is the JSON property whose value identifies which alternative is present, or empty if the
+	// alternatives have no such property in common
+	Discriminator string
+	// Alternatives are the possible types of the value
+	Alternatives []UnionAlternative
+}
+
+// UnionAlternative captures a single alternative of a union
+type UnionAlternative struct {
+	// FieldName is the name of the field holding this alternative
+	FieldName FieldName
+	// Type is the type of this alternative
+	Type Type
+	// DiscriminatorValue is the JSON value of the discriminator which selects this alternative
+	DiscriminatorValue string
+}
+
+// UnionType must implement the Type interface correctly
+var _ Type = (*UnionType)(nil)
+
+// UnionType must implement the HasRelatedDefinitions interface correctly
+var _ HasRelatedDefinitions = (*UnionType)(nil)
+
+// NewUnionType defines a new union of the given alternatives; discriminator may be empty
+func NewUnionType(discriminator string, alternatives []UnionAlternative) *UnionType {
+	return &UnionType{Discriminator: discriminator, Alternatives: alternatives}
+}
+
+// AsType implements Type for UnionType
+func (union *UnionType) AsType() ast.Expr {
+	return ast.NewIdent(union.name)
+}
+
+// References indicates whether this Type includes any direct references to the given Type?
+func (union *UnionType) References(t Type) bool {
+	return union.DefinitionName.References(t)
+}
+
+// Equals returns true if the passed type is a union with the same alternatives, false otherwise
+func (union *UnionType) Equals(t Type) bool {
+	if union == t {
+		return true
+	}
+
+	if other, ok := t.(*UnionType); ok {
+		if union.Discriminator != other.Discriminator || len(union.Alternatives) != len(other.Alternatives) {
+			return false
+		}
+
+		for i, alternative := range union.Alternatives {
+			otherAlternative := other.Alternatives[i]
+			if alternative.FieldName != otherAlternative.FieldName ||
+				alternative.DiscriminatorValue != otherAlternative.DiscriminatorValue ||
+				!alternative.Type.Equals(otherAlternative.Type) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// RelatedDefinitions implements the HasRelatedDefinitions interface for UnionType
+func (union *UnionType) RelatedDefinitions(ref PackageReference, namehint string, idFactory IdentifierFactory) []Definition {
+	if union.name != "" {
+		// already defined, such as when the union was named after the definition which contains it
+		return nil
+	}
+
+	union.DefinitionName = DefinitionName{PackageReference: ref, name: idFactory.CreateIdentifier(namehint)}
+
+	result := []Definition{&UnionDefinition{UnionType: *union}}
+	for _, alternative := range union.Alternatives {
+		if df, ok := alternative.Type.(HasRelatedDefinitions); ok {
+			nh := namehint + "." + string(alternative.FieldName)
+			result = append(result, df.RelatedDefinitions(ref, nh, idFactory)...)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package astmodel
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_UnionTypeEquals_GivenSameAlternatives_ReturnsTrue(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	union := NewUnionType("", []UnionAlternative{{FieldName: "Integer", Type: IntType}, {FieldName: "String", Type: StringType}})
+	other := NewUnionType("", []UnionAlternative{{FieldName: "Integer", Type: IntType}, {FieldName: "String", Type: StringType}})
+
+	g.Expect(union.Equals(other)).To(BeTrue())
+}
+
+func Test_UnionTypeEquals_GivenDifferentAlternatives_ReturnsFalse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	union := NewUnionType("", []UnionAlternative{{FieldName: "Integer", Type: IntType}, {FieldName: "String", Type: StringType}})
+	other := NewUnionType("", []UnionAlternative{{FieldName: "Integer", Type: IntType}, {FieldName: "Bool", Type: BoolType}})
+
+	g.Expect(union.Equals(other)).To(BeFalse())
+}
+
+func Test_UnionTypeRelatedDefinitions_GivenNameHint_DefinesUnion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ref := PackageReference{groupName: "group", packageName: "v20200101"}
+	union := NewUnionType("kind", []UnionAlternative{
+		{FieldName: "Image", Type: StringType, DiscriminatorValue: `"Image"`},
+		{FieldName: "Disk", Type: StringType, DiscriminatorValue: `"Disk"`},
+	})
+
+	definitions := union.RelatedDefinitions(ref, "Test.Source", NewIdentifierFactory())
+
+	g.Expect(definitions).To(HaveLen(1))
+	g.Expect(definitions[0].Reference().Name()).To(Equal("TestSource"))
+	g.Expect(union.Name()).To(Equal("TestSource"))
+}
+
+func Test_UnionDefinitionRequiredImports_IncludesEncodingJSON(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	union := NewUnionType("", []UnionAlternative{{FieldName: "Integer", Type: IntType}, {FieldName: "String", Type: StringType}})
+	definition := &UnionDefinition{*union}
+
+	g.Expect(definition.RequiredImports()).To(ContainElement(NewLibraryPackageReference("encoding/json")))
+}
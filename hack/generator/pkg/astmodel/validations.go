@@ -8,6 +8,7 @@ package astmodel
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -19,22 +20,46 @@ type Validation struct {
 	value interface{}
 }
 
+// topologyMarkers are the validations which describe how the API server merges a value, rather than constraining it,
+// so are not kubebuilder:validation markers
+var topologyMarkers = map[string]bool{
+	"listType": true,
+}
+
+// elementMarkers are the validations which constrain the values of a field, or the items of an array field, so only
+// make sense for the type they were written for
+var elementMarkers = map[string]bool{
+	"Enum":             true,
+	"Minimum":          true,
+	"Maximum":          true,
+	"ExclusiveMinimum": true,
+	"ExclusiveMaximum": true,
+	"MultipleOf":       true,
+	"MinLength":        true,
+	"MaxLength":        true,
+	"Pattern":          true,
+	"listType":         true,
+}
+
 // GenerateKubebuilderComment converts the given validation to
 // a Kubebuilder magic comment
 func GenerateKubebuilderComment(validation Validation) string {
-	const prefix = "// +kubebuilder:validation:"
+	prefix := "// +kubebuilder:validation:"
+	if topologyMarkers[validation.name] {
+		prefix = "// +"
+	}
 
 	if validation.value != nil {
 		value := reflect.ValueOf(validation.value)
 
 		if value.Kind() == reflect.Slice {
-			// handle slice values which should look like "x,y,z"
+			// handle slice values which should look like "x;y;z"
 			var values []string
 			for i := 0; i < value.Len(); i++ {
 				values = append(values, fmt.Sprintf("%v", value.Index(i)))
 			}
 
-			return fmt.Sprintf("%s%s=%s", prefix, validation.name, strings.Join(values, ","))
+			return fmt.Sprintf("%s%s=%s", prefix, validation.name, strings.Join(values, ";"))
 		}
 
 		// everything else
@@ -55,3 +80,65 @@ func ValidateEnum(permittedValues []interface{}) Validation {
 func ValidateRequired() Validation {
 	return Validation{"Required", nil}
 }
+
+// ValidateMinimum returns a Validation that requires a number be no less than 'minimum'
+func ValidateMinimum(minimum int64) Validation {
+	return Validation{"Minimum", minimum}
+}
+
+// ValidateMaximum returns a Validation that requires a number be no greater than 'maximum'
+func ValidateMaximum(maximum int64) Validation {
+	return Validation{"Maximum", maximum}
+}
+
+// ValidateExclusiveMinimum returns a Validation that excludes the minimum itself from the permitted values
+func ValidateExclusiveMinimum() Validation {
+	return Validation{"ExclusiveMinimum", true}
+}
+
+// ValidateExclusiveMaximum returns a Validation that excludes the maximum itself from the permitted values
+func ValidateExclusiveMaximum() Validation {
+	return Validation{"ExclusiveMaximum", true}
+}
+
+// ValidateMultipleOf returns a Validation that requires a number be a multiple of 'factor'
+func ValidateMultipleOf(factor int64) Validation {
+	return Validation{"MultipleOf", factor}
+}
+
+// ValidateMinLength returns a Validation that requires a string be at least 'length' characters long
+func ValidateMinLength(length int) Validation {
+	return Validation{"MinLength", length}
+}
+
+// ValidateMaxLength returns a Validation that requires a string be at most 'length' characters long
+func ValidateMaxLength(length int) Validation {
+	return Validation{"MaxLength", length}
+}
+
+// ValidatePattern returns a Validation that requires a string match the regular expression 'pattern'
+func ValidatePattern(pattern string) Validation {
+	// a raw string keeps the escapes of the pattern readable, but can't itself contain a backtick
+	quoted := "`" + pattern + "`"
+	if strings.Contains(pattern, "`") {
+		quoted = strconv.Quote(pattern)
+	}
+
+	return Validation{"Pattern", quoted}
+}
+
+// ValidateMinItems returns a Validation that requires an array have at least 'count' items
+func ValidateMinItems(count int) Validation {
+	return Validation{"MinItems", count}
+}
+
+// ValidateMaxItems returns a Validation that requires an array have at most 'count' items
+func ValidateMaxItems(count int) Validation {
+	return Validation{"MaxItems", count}
+}
+
+// ValidateUniqueItems returns a Validation that requires the items of an array be distinct. The API server rejects
+// uniqueItems, so the array is declared a set instead, which is only permitted for arrays of scalars.
+func ValidateUniqueItems() Validation {
+	return Validation{"listType", "set"}
+}
@@ -26,5 +26,50 @@ func Test_ValidateEnum(t *testing.T) {
 	validation := ValidateEnum([]interface{}{1, true, "hello"})
 	comment := GenerateKubebuilderComment(validation)
 
-	g.Expect(comment).To(Equal("// +kubebuilder:validation:Enum=1,true,hello"))
+	g.Expect(comment).To(Equal("// +kubebuilder:validation:Enum=1;true;hello"))
+}
+
+func Test_ValidateMinimum(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	validation := ValidateMinimum(-5)
+	comment := GenerateKubebuilderComment(validation)
+
+	g.Expect(comment).To(Equal("// +kubebuilder:validation:Minimum=-5"))
+}
+
+func Test_ValidateExclusiveMaximum(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	validation := ValidateExclusiveMaximum()
+	comment := GenerateKubebuilderComment(validation)
+
+	g.Expect(comment).To(Equal("// +kubebuilder:validation:ExclusiveMaximum=true"))
+}
+
+func Test_ValidatePattern(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	validation := ValidatePattern(`^\d+$`)
+	comment := GenerateKubebuilderComment(validation)
+
+	g.Expect(comment).To(Equal("// +kubebuilder:validation:Pattern=`^\\d+$`"))
+}
+
+func Test_ValidatePattern_GivenBacktick_QuotesPattern(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	validation := ValidatePattern("^[^`]+$")
+	comment := GenerateKubebuilderComment(validation)
+
+	g.Expect(comment).To(Equal(`// +kubebuilder:validation:Pattern="^[^` + "`" + `]+$"`))
+}
+
+func Test_ValidateUniqueItems(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	validation := ValidateUniqueItems()
+	comment := GenerateKubebuilderComment(validation)
+
+	g.Expect(comment).To(Equal("// +listType=set"))
 }
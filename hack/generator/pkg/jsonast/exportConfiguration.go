@@ -15,8 +15,15 @@ import (
 type ExportConfiguration struct {
 	// Base URL for the JSON schema to generate
 	SchemaURL string
+	// Directory to write the generated packages into; defaults to apis
+	OutputPath string
+	// Import path of the output directory, used when generated packages import each other; defaults to
+	// astmodel.DefaultGeneratedPackagesRoot
+	PackageRoot string
 	// Filters used to control which types are included
 	TypeFilters []*TypeFilter
+	// Transformers used to rename types and fields, and to override the types of fields
+	TypeTransformers []*TypeTransformer
 }
 
 // ShouldExportResult is returned by ShouldExport to indicate whether the supplied type should be exported
@@ -44,9 +51,21 @@ func (config *ExportConfiguration) Validate() error {
 		return errors.New("SchemaURL missing")
 	}
 
+	for _, transformer := range config.TypeTransformers {
+		if err := transformer.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// Transform applies the type transformers to the given definitions, returning the transformed definitions; references
+// to any type renamed are updated to match
+func (config *ExportConfiguration) Transform(definitions []astmodel.Definition) ([]astmodel.Definition, error) {
+	return transformDefinitions(config.TypeTransformers, definitions)
+}
+
 // ShouldExport tests for whether a given struct should be exported
 // Returns a result indicating whether export should occur as well as a reason for logging
 func (config *ExportConfiguration) ShouldExport(definition astmodel.Definition) (result ShouldExportResult, because string) {
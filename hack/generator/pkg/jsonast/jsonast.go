@@ -9,8 +9,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/big"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/Azure/k8s-infra/hack/generator/pkg/astmodel"
@@ -40,8 +42,10 @@ type (
 	SchemaScanner struct {
 		Definitions  map[astmodel.DefinitionName]astmodel.Definition
 		TypeHandlers map[SchemaType]TypeHandler
-		Filters      []string
-		idFactory    astmodel.IdentifierFactory
+		// ResourceFilters select the resources to scan; if there are none, every resource is scanned
+		ResourceFilters []*ResourceFilter
+		idFactory       astmodel.IdentifierFactory
+		enums           map[astmodel.PackageReference][]*astmodel.EnumType
 	}
 )
 
@@ -87,6 +91,7 @@ func NewSchemaScanner(idFactory astmodel.IdentifierFactory) *SchemaScanner {
 		Definitions:  make(map[astmodel.DefinitionName]astmodel.Definition),
 		TypeHandlers: DefaultTypeHandlers(),
 		idFactory:    idFactory,
+		enums:        make(map[astmodel.PackageReference][]*astmodel.EnumType),
 	}
 }
 
@@ -112,13 +117,49 @@ func (scanner *SchemaScanner) RunHandlerForSchema(ctx context.Context, schema *g
 	return scanner.RunHandler(ctx, schemaType, schema)
 }
 
-// AddFilters will add a filter (perhaps not currently used?)
-func (scanner *SchemaScanner) AddFilters(filters []string) {
-	scanner.Filters = append(scanner.Filters, filters...)
+// AddResourceFilters parses the given {resource type}@{api version} filters and adds them to those selecting the
+// resources to scan. Resources matching none of the filters are pruned from the scan, so only the definitions needed
+// by the selected resources are generated.
+func (scanner *SchemaScanner) AddResourceFilters(filters []string) error {
+	for _, f := range filters {
+		filter, err := NewResourceFilter(f)
+		if err != nil {
+			return err
+		}
+
+		scanner.ResourceFilters = append(scanner.ResourceFilters, filter)
+	}
+
+	return nil
+}
+
+// includesResource indicates whether the given resource definition is selected by the resource filters
+func (scanner *SchemaScanner) includesResource(schema *gojsonschema.SubSchema) bool {
+	if len(scanner.ResourceFilters) == 0 {
+		return true
+	}
+
+	properties := fixedStringProperties(schema)
+	resourceType, ok := properties["type"]
+	if !ok {
+		// without a resource type we cannot tell, so err on the side of generating too much
+		log.Printf("WRN Unable to find the resource type of %s, including it\n", schema.ID)
+		return true
+	}
+
+	resourceType = strings.Trim(resourceType, "\"")
+	apiVersion := strings.Trim(properties["apiVersion"], "\"")
+	for _, filter := range scanner.ResourceFilters {
+		if filter.Matches(resourceType, apiVersion) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // ToNodes takes in the resources section of the Azure deployment template schema and returns golang AST Packages
-//    containing the types described in the schema which match the {resource_type}/{version} filters provided.
+//    containing the types described in the schema which match the {resource type}@{api version} filters provided.
 //
 // 		The schema we are working with is something like the following (in yaml for brevity):
 //
@@ -192,6 +233,12 @@ func (scanner *SchemaScanner) ToNodes(ctx context.Context, schema *gojsonschema.
 
 	scanner.AddDefinition(root)
 
+	// Add any further definitions related to the root
+	relatedDefinitions := root.RelatedDefinitions(rootStructRef.PackageReference, rootStructRef.Name(), scanner.idFactory)
+	for _, d := range relatedDefinitions {
+		scanner.AddDefinition(d)
+	}
+
 	return root, nil
 }
 
@@ -237,7 +284,39 @@ func enumHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonsche
 
 	enumType := astmodel.NewEnumType(baseType, values)
 
-	return enumType, nil
+	return scanner.findEquivalentEnum(schema, enumType), nil
+}
+
+// findEquivalentEnum returns an enum already seen in the package of the schema which has the
+// same values as the one given, so that only one definition is generated for them; if there
+// is none, the given enum is recorded and returned
+func (scanner *SchemaScanner) findEquivalentEnum(schema *gojsonschema.SubSchema, enumType *astmodel.EnumType) *astmodel.EnumType {
+	if schema.ID == nil {
+		return enumType
+	}
+
+	group, err := groupOf(schema.ID.GetUrl())
+	if err != nil {
+		return enumType
+	}
+
+	version, err := versionOf(schema.ID.GetUrl())
+	if err != nil {
+		return enumType
+	}
+
+	pkg := astmodel.NewPackageReference(
+		scanner.idFactory.CreateGroupName(group),
+		scanner.idFactory.CreatePackageNameFromVersion(version))
+
+	for _, existing := range scanner.enums[pkg] {
+		if existing.Equals(enumType) {
+			return existing
+		}
+	}
+
+	scanner.enums[pkg] = append(scanner.enums[pkg], enumType)
+	return enumType
 }
 
 func fixedTypeHandler(typeToReturn astmodel.Type, handlerName string) TypeHandler {
@@ -294,6 +373,11 @@ func generateFieldDefinition(ctx context.Context, scanner *SchemaScanner, prop *
 		return nil, err
 	}
 
+	if propType == nil {
+		// the property has no type, such as when it refers to a resource we are not generating, so there is no field
+		return nil, nil
+	}
+
 	field := astmodel.NewFieldDefinition(fieldName, prop.Property, propType)
 	return field, nil
 }
@@ -302,17 +386,32 @@ func getFields(ctx context.Context, scanner *SchemaScanner, schema *gojsonschema
 	ctx, span := tab.StartSpan(ctx, "getFields")
 	defer span.End()
 
+	// the properties are parsed from a map, so visit them in a stable order; otherwise which of several equivalent
+	// enums is seen first, and so names them all, would vary from run to run
+	properties := make([]*gojsonschema.SubSchema, len(schema.PropertiesChildren))
+	copy(properties, schema.PropertiesChildren)
+	sort.Slice(properties, func(i int, j int) bool {
+		return properties[i].Property < properties[j].Property
+	})
+
 	var fields []*astmodel.FieldDefinition
-	for _, prop := range schema.PropertiesChildren {
+	for _, prop := range properties {
 
 		fieldDefinition, err := generateFieldDefinition(ctx, scanner, prop)
 		if err != nil {
 			return nil, err
 		}
 
+		if fieldDefinition == nil {
+			continue // ignore skipped properties
+		}
+
 		// add documentation
 		fieldDefinition = fieldDefinition.WithDescription(prop.Description)
 
+		// check the type before it becomes optional
+		enumType, isEnum := fieldDefinition.FieldType().(*astmodel.EnumType)
+
 		// add validations
 		isRequired := false
 		for _, required := range schema.Required {
@@ -328,6 +427,15 @@ func getFields(ctx context.Context, scanner *SchemaScanner, schema *gojsonschema
 			fieldDefinition = fieldDefinition.MakeOptional()
 		}
 
+		// restrict enum valued fields to the values of the enum
+		if isEnum {
+			fieldDefinition = fieldDefinition.WithValidation(astmodel.ValidateEnum(enumType.Values()))
+		}
+
+		for _, validation := range getScalarValidations(prop) {
+			fieldDefinition = fieldDefinition.WithValidation(validation)
+		}
+
 		fields = append(fields, fieldDefinition)
 	}
 
@@ -349,6 +457,10 @@ func getFields(ctx context.Context, scanner *SchemaScanner, schema *gojsonschema
 			return nil, err
 		}
 
+		if additionalPropsType == nil {
+			return fields, nil // ignore skipped types
+		}
+
 		additionalPropsField := astmodel.NewFieldDefinition(astmodel.FieldName("additionalProperties"), "additionalProperties", astmodel.NewStringMap(additionalPropsType))
 		fields = append(fields, additionalPropsField)
 	}
@@ -356,6 +468,112 @@ func getFields(ctx context.Context, scanner *SchemaScanner, schema *gojsonschema
 	return fields, nil
 }
 
+// getScalarValidations converts the numeric, string and array constraints of a property into validations.
+// ARM frequently allows a template expression in place of a value, so if the property is a oneOf or anyOf
+// with only one alternative besides expressions, the constraints of that alternative are used.
+func getScalarValidations(prop *gojsonschema.SubSchema) []astmodel.Validation {
+	schema := valueAlternative(prop)
+
+	var result []astmodel.Validation
+
+	addBound := func(bound *big.Rat, validate func(int64) astmodel.Validation, exclusive ...astmodel.Validation) {
+		if bound == nil {
+			return
+		}
+
+		// kubebuilder only supports integer bounds
+		if !bound.IsInt() || !bound.Num().IsInt64() {
+			log.Printf("WRN Ignoring non-integer bound %s of %s\n", bound.RatString(), prop.Property)
+			return
+		}
+
+		result = append(result, validate(bound.Num().Int64()))
+		result = append(result, exclusive...)
+	}
+
+	addBound(schema.Minimum, astmodel.ValidateMinimum)
+	addBound(schema.ExclusiveMinimum, astmodel.ValidateMinimum, astmodel.ValidateExclusiveMinimum())
+	addBound(schema.Maximum, astmodel.ValidateMaximum)
+	addBound(schema.ExclusiveMaximum, astmodel.ValidateMaximum, astmodel.ValidateExclusiveMaximum())
+	addBound(schema.MultipleOf, astmodel.ValidateMultipleOf)
+
+	if schema.MinLength != nil {
+		result = append(result, astmodel.ValidateMinLength(*schema.MinLength))
+	}
+
+	if schema.MaxLength != nil {
+		result = append(result, astmodel.ValidateMaxLength(*schema.MaxLength))
+	}
+
+	if schema.Pattern != nil {
+		result = append(result, astmodel.ValidatePattern(schema.Pattern.String()))
+	}
+
+	if schema.MinItems != nil {
+		result = append(result, astmodel.ValidateMinItems(*schema.MinItems))
+	}
+
+	if schema.MaxItems != nil {
+		result = append(result, astmodel.ValidateMaxItems(*schema.MaxItems))
+	}
+
+	if schema.UniqueItems {
+		if hasScalarItems(schema) {
+			result = append(result, astmodel.ValidateUniqueItems())
+		} else {
+			log.Printf("WRN Ignoring uniqueItems of %s, as only arrays of scalars may be sets\n", prop.Property)
+		}
+	}
+
+	return result
+}
+
+// hasScalarItems indicates whether the items of an array are strings, numbers or booleans
+func hasScalarItems(schema *gojsonschema.SubSchema) bool {
+	if len(schema.ItemsChildren) != 1 {
+		return false
+	}
+
+	items := schema.ItemsChildren[0]
+	for items.RefSchema != nil && items.Enum == nil {
+		items = items.RefSchema
+	}
+
+	itemsType, err := getSubSchemaType(items)
+	if err != nil {
+		return false
+	}
+
+	switch itemsType {
+	case Enum, String, Number, Int, Bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// valueAlternative returns the only alternative of a oneOf or anyOf which is not a template
+// expression, or the schema itself if there is no such single alternative
+func valueAlternative(schema *gojsonschema.SubSchema) *gojsonschema.SubSchema {
+	alternatives := schema.OneOf
+	if alternatives == nil {
+		alternatives = schema.AnyOf
+	}
+
+	var values []*gojsonschema.SubSchema
+	for _, alternative := range alternatives {
+		if alternative.Ref == nil || alternative.Ref.GetUrl().Fragment != expressionFragment {
+			values = append(values, alternative)
+		}
+	}
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return schema
+}
+
 func refHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonschema.SubSchema) (astmodel.Type, error) {
 	ctx, span := tab.StartSpan(ctx, "refHandler")
 	defer span.End()
@@ -388,6 +606,9 @@ func refHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonschem
 	}
 
 	isResource := isResource(url)
+	if isResource && !scanner.includesResource(schema.RefSchema) {
+		return nil, nil
+	}
 
 	// produce a usable struct name:
 	structReference := astmodel.NewStructReference(
@@ -421,6 +642,9 @@ func refHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonschem
 		description := "Generated from: " + url.String()
 
 		sd := astmodel.NewStructDefinition(structReference, structType.Fields()...).WithDescription(&description)
+		if isResource {
+			sd = sd.WithARMType(resourceTypeOf(schema.RefSchema))
+		}
 
 		// this will overwrite placeholder added above
 		scanner.AddDefinition(sd)
@@ -434,6 +658,16 @@ func refHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonschem
 		return sd.Reference(), nil
 	}
 
+	// if we got back a union, name it after the definition
+	if unionType, ok := result.(*astmodel.UnionType); ok {
+		relatedDefinitions := unionType.RelatedDefinitions(structReference.PackageReference, structReference.Name(), scanner.idFactory)
+		for _, d := range relatedDefinitions {
+			scanner.AddDefinition(d)
+		}
+
+		return unionType, nil
+	}
+
 	return result, err
 }
 
@@ -478,52 +712,158 @@ func oneOfHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonsch
 	ctx, span := tab.StartSpan(ctx, "oneOfHandler")
 	defer span.End()
 
-	// make sure we visit everything before bailing out,
-	// to get all types generated even if we can't use them
+	return unionHandler(ctx, scanner, schema.OneOf)
+}
+
+func anyOfHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonschema.SubSchema) (astmodel.Type, error) {
+	ctx, span := tab.StartSpan(ctx, "anyOfHandler")
+	defer span.End()
+
+	// anyOf permits a value to match several alternatives at once, but ARM only ever
+	// needs one of them, so it is handled in the same way as oneOf
+	return unionHandler(ctx, scanner, schema.AnyOf)
+}
+
+// unionHandler generates a union of the types of the given alternatives, keyed on the
+// discriminator property they share if there is one
+func unionHandler(ctx context.Context, scanner *SchemaScanner, alternatives []*gojsonschema.SubSchema) (astmodel.Type, error) {
+	// make sure we visit everything, skipping alternatives
+	// which produce no type (such as ARM template expressions)
 	var results []astmodel.Type
-	for _, one := range schema.OneOf {
-		result, err := scanner.RunHandlerForSchema(ctx, one)
+	var schemas []*gojsonschema.SubSchema
+	for _, alternative := range alternatives {
+		result, err := scanner.RunHandlerForSchema(ctx, alternative)
 		if err != nil {
 			return nil, err
 		}
 
 		if result != nil {
 			results = append(results, result)
+			schemas = append(schemas, alternative)
 		}
 	}
 
+	if len(results) == 0 {
+		return nil, nil
+	}
+
 	if len(results) == 1 {
 		return results[0], nil
 	}
 
-	// bail out, can't handle this yet:
-	return astmodel.AnyType, nil
+	discriminator, values := findDiscriminator(schemas)
+
+	var unionAlternatives []astmodel.UnionAlternative
+	fieldNames := make(map[astmodel.FieldName]int)
+	for i, result := range results {
+		fieldName := scanner.idFactory.CreateFieldName(alternativeName(schemas[i]))
+		if count := fieldNames[fieldName]; count > 0 {
+			fieldNames[fieldName] = count + 1
+			fieldName = astmodel.FieldName(fmt.Sprintf("%s%d", fieldName, count+1))
+		} else {
+			fieldNames[fieldName] = 1
+		}
+
+		alternative := astmodel.UnionAlternative{FieldName: fieldName, Type: result}
+		if discriminator != "" {
+			alternative.DiscriminatorValue = values[i]
+		}
+
+		unionAlternatives = append(unionAlternatives, alternative)
+	}
+
+	return astmodel.NewUnionType(discriminator, unionAlternatives), nil
 }
 
-func anyOfHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonschema.SubSchema) (astmodel.Type, error) {
-	ctx, span := tab.StartSpan(ctx, "anyOfHandler")
-	defer span.End()
+// alternativeName chooses a name for an alternative of a union, preferring the name of
+// the definition it refers to, then its title, and finally the kind of schema it is
+func alternativeName(schema *gojsonschema.SubSchema) string {
+	if schema.RefSchema != nil && schema.Ref.GetUrl().Fragment != "" {
+		if name, err := objectTypeOf(schema.Ref.GetUrl()); err == nil {
+			return name
+		}
+	}
 
-	// again, make sure we walk everything first
-	// to generate types:
-	var results []astmodel.Type
-	for _, any := range schema.AnyOf {
-		result, err := scanner.RunHandlerForSchema(ctx, any)
-		if err != nil {
-			return nil, err
+	if schema.Title != nil {
+		return *schema.Title
+	}
+
+	schemaType, err := getSubSchemaType(schema)
+	if err != nil {
+		return "value"
+	}
+
+	return string(schemaType)
+}
+
+// findDiscriminator looks for a property which every alternative fixes to a single, distinct,
+// string value, returning its name along with the JSON value for each alternative.
+// If there is no such property, the name returned is empty.
+func findDiscriminator(schemas []*gojsonschema.SubSchema) (string, []string) {
+	var candidates []map[string]string
+	for _, schema := range schemas {
+		candidates = append(candidates, fixedStringProperties(schema))
+	}
+
+	var names []string
+	for name := range candidates[0] {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		var values []string
+		seen := make(map[string]bool)
+		for _, properties := range candidates {
+			value, ok := properties[name]
+			if !ok || seen[value] {
+				break
+			}
+
+			seen[value] = true
+			values = append(values, value)
 		}
 
-		if result != nil {
-			results = append(results, result)
+		if len(values) == len(schemas) {
+			return name, values
 		}
 	}
 
-	if len(results) == 1 {
-		return results[0], nil
+	return "", nil
+}
+
+// fixedStringProperties finds the properties of a schema (including any it references or
+// is composed of) which are an enum of exactly one string value
+func fixedStringProperties(schema *gojsonschema.SubSchema) map[string]string {
+	result := make(map[string]string)
+
+	var walk func(s *gojsonschema.SubSchema)
+	walk = func(s *gojsonschema.SubSchema) {
+		if s == nil {
+			return
+		}
+
+		for _, prop := range s.PropertiesChildren {
+			if len(prop.Enum) == 1 && strings.HasPrefix(prop.Enum[0], "\"") {
+				result[prop.Property] = prop.Enum[0]
+			}
+		}
+
+		walk(s.RefSchema)
+		for _, all := range s.AllOf {
+			walk(all)
+		}
 	}
 
-	// return all possibilities...
-	return astmodel.AnyType, nil
+	walk(schema)
+	return result
+}
+
+// resourceTypeOf returns the type of the Azure resource the schema defines, such as Microsoft.Network/virtualNetworks,
+// or "" if the schema does not fix it
+func resourceTypeOf(schema *gojsonschema.SubSchema) string {
+	return strings.Trim(fixedStringProperties(schema)["type"], "\"")
 }
 
 func arrayHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonschema.SubSchema) (astmodel.Type, error) {
@@ -550,6 +890,11 @@ func arrayHandler(ctx context.Context, scanner *SchemaScanner, schema *gojsonsch
 		return nil, err
 	}
 
+	if astType == nil {
+		// the items have no type, such as when they are resources we are not generating, so neither does the array
+		return nil, nil
+	}
+
 	return astmodel.NewArrayType(astType), nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 
@@ -37,8 +38,25 @@ func runGoldenTest(t *testing.T, path string) {
 	scanner := NewSchemaScanner(astmodel.NewIdentifierFactory())
 	nodes, err := scanner.ToNodes(context.TODO(), schema.Root())
 
+	// include any definitions generated alongside the root, in a stable order
+	declarations := nodes.AsDeclarations()
+	var related []astmodel.Definition
+	for _, def := range scanner.Definitions {
+		if def != nodes {
+			related = append(related, def)
+		}
+	}
+
+	sort.Slice(related, func(i int, j int) bool {
+		return related[i].Reference().Name() < related[j].Reference().Name()
+	})
+
+	for _, def := range related {
+		declarations = append(declarations, def.AsDeclarations()...)
+	}
+
 	buf := &bytes.Buffer{}
-	format.Node(buf, token.NewFileSet(), nodes.AsDeclarations())
+	format.Node(buf, token.NewFileSet(), declarations)
 
 	g.Assert(t, testName, buf.Bytes())
 }
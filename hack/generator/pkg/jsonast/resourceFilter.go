@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package jsonast
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// A ResourceFilter selects the ARM resources to scan by resource type and API version, written as
+// {resource type}@{api version}, for example Microsoft.Network/virtualNetworks@2019-11-01. Either part may use
+// wildcards, and the API version may be omitted to select every version of the resource type.
+type ResourceFilter struct {
+	filter          string
+	typeRegex       *regexp.Regexp
+	apiVersionRegex *regexp.Regexp
+}
+
+// NewResourceFilter parses a filter of the form {resource type}@{api version}
+func NewResourceFilter(filter string) (*ResourceFilter, error) {
+	parts := strings.Split(filter, "@")
+	if len(parts) > 2 || parts[0] == "" {
+		return nil, fmt.Errorf("resource filter %q is not of the form {resource type}@{api version}", filter)
+	}
+
+	result := &ResourceFilter{
+		filter: filter,
+		// ARM resource types are case insensitive
+		typeRegex: regexp.MustCompile("(?i)" + createGlobbingRegex(parts[0]).String()),
+	}
+
+	if len(parts) == 2 && parts[1] != "" {
+		result.apiVersionRegex = createGlobbingRegex(parts[1])
+	}
+
+	return result, nil
+}
+
+// Matches indicates whether this filter selects the resource of the given type and API version
+func (filter *ResourceFilter) Matches(resourceType string, apiVersion string) bool {
+	if !filter.typeRegex.MatchString(resourceType) {
+		return false
+	}
+
+	return filter.apiVersionRegex == nil || filter.apiVersionRegex.MatchString(apiVersion)
+}
+
+// String returns the filter as written
+func (filter *ResourceFilter) String() string {
+	return filter.filter
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package jsonast
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/Azure/k8s-infra/hack/generator/pkg/astmodel"
+)
+
+func Test_ResourceFilter_GivenTypeAndVersion_MatchesOnlyThatVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	filter, err := NewResourceFilter("Microsoft.Network/virtualNetworks@2019-11-01")
+
+	g.Expect(err).To(BeNil())
+	g.Expect(filter.Matches("Microsoft.Network/virtualNetworks", "2019-11-01")).To(BeTrue())
+	g.Expect(filter.Matches("microsoft.network/virtualnetworks", "2019-11-01")).To(BeTrue())
+	g.Expect(filter.Matches("Microsoft.Network/virtualNetworks", "2020-03-01")).To(BeFalse())
+	g.Expect(filter.Matches("Microsoft.Network/virtualNetworks/subnets", "2019-11-01")).To(BeFalse())
+}
+
+func Test_ResourceFilter_GivenWildcardsAndNoVersion_MatchesEveryVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	filter, err := NewResourceFilter("Microsoft.Storage/*")
+
+	g.Expect(err).To(BeNil())
+	g.Expect(filter.Matches("Microsoft.Storage/storageAccounts", "2019-06-01")).To(BeTrue())
+	g.Expect(filter.Matches("Microsoft.Network/virtualNetworks", "2019-06-01")).To(BeFalse())
+}
+
+func Test_NewResourceFilter_GivenMalformedFilter_ReturnsError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := NewResourceFilter("@2019-11-01")
+	g.Expect(err).NotTo(BeNil())
+
+	_, err = NewResourceFilter("Microsoft.Network/virtualNetworks@2019-11-01@2020-03-01")
+	g.Expect(err).NotTo(BeNil())
+}
+
+const resourcesSchema = `{
+  "id": "https://schema.management.azure.com/schemas/2020-01-01/Microsoft.Test.json#",
+  "title": "Test",
+  "type": "object",
+  "properties": {
+    "resources": {
+      "type": "array",
+      "items": {
+        "oneOf": [
+          { "$ref": "#/resourceDefinitions/widgets" },
+          { "$ref": "#/resourceDefinitions/gadgets" }
+        ]
+      }
+    }
+  },
+  "resourceDefinitions": {
+    "widgets": {
+      "type": "object",
+      "properties": {
+        "type": { "type": "string", "enum": [ "Microsoft.Test/widgets" ] },
+        "apiVersion": { "type": "string", "enum": [ "2020-01-01" ] },
+        "properties": { "$ref": "#/definitions/WidgetProperties" }
+      }
+    },
+    "gadgets": {
+      "type": "object",
+      "properties": {
+        "type": { "type": "string", "enum": [ "Microsoft.Test/gadgets" ] },
+        "apiVersion": { "type": "string", "enum": [ "2020-01-01" ] },
+        "properties": { "$ref": "#/definitions/GadgetProperties" }
+      }
+    }
+  },
+  "definitions": {
+    "WidgetProperties": {
+      "type": "object",
+      "properties": {
+        "size": { "type": "integer" },
+        "gadget": { "$ref": "#/resourceDefinitions/gadgets" },
+        "gadgets": { "type": "array", "items": { "$ref": "#/resourceDefinitions/gadgets" } }
+      }
+    },
+    "GadgetProperties": {
+      "type": "object",
+      "properties": { "colour": { "type": "string" } }
+    }
+  }
+}`
+
+func scanResources(g *GomegaWithT, filters ...string) []string {
+	schema, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewStringLoader(resourcesSchema))
+	g.Expect(err).To(BeNil())
+
+	scanner := NewSchemaScanner(astmodel.NewIdentifierFactory())
+	g.Expect(scanner.AddResourceFilters(filters)).To(Succeed())
+
+	_, err = scanner.ToNodes(context.TODO(), schema.Root())
+	g.Expect(err).To(BeNil())
+
+	var names []string
+	for name := range scanner.Definitions {
+		names = append(names, name.Name())
+	}
+
+	return names
+}
+
+func Test_ToNodes_GivenResourceFilter_ScansOnlySelectedResources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	names := scanResources(g, "Microsoft.Test/widgets@2020-01-01")
+
+	g.Expect(names).To(ContainElement("Widgets"))
+	g.Expect(names).To(ContainElement("WidgetProperties"))
+	g.Expect(names).NotTo(ContainElement("Gadgets"))
+	g.Expect(names).NotTo(ContainElement("GadgetProperties"))
+}
+
+func Test_ToNodes_GivenNoResourceFilters_ScansEveryResource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	names := scanResources(g)
+
+	g.Expect(names).To(ContainElement("Widgets"))
+	g.Expect(names).To(ContainElement("Gadgets"))
+}
+
+func Test_ToNodes_GivenResource_RecordsARMType(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewStringLoader(resourcesSchema))
+	g.Expect(err).To(BeNil())
+
+	scanner := NewSchemaScanner(astmodel.NewIdentifierFactory())
+	_, err = scanner.ToNodes(context.TODO(), schema.Root())
+	g.Expect(err).To(BeNil())
+
+	for name, def := range scanner.Definitions {
+		if name.Name() == "Widgets" {
+			g.Expect(def.(*astmodel.StructDefinition).ARMType()).To(Equal("Microsoft.Test/widgets"))
+			return
+		}
+	}
+
+	t.Fatal("Widgets was not generated")
+}
+
+func Test_ToNodes_GivenResourceFilter_OmitsFieldsOfExcludedResources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewStringLoader(resourcesSchema))
+	g.Expect(err).To(BeNil())
+
+	scanner := NewSchemaScanner(astmodel.NewIdentifierFactory())
+	g.Expect(scanner.AddResourceFilters([]string{"Microsoft.Test/widgets@2020-01-01"})).To(Succeed())
+
+	_, err = scanner.ToNodes(context.TODO(), schema.Root())
+	g.Expect(err).To(BeNil())
+
+	for name, def := range scanner.Definitions {
+		if name.Name() == "WidgetProperties" {
+			properties := def.(*astmodel.StructDefinition)
+			g.Expect(properties.FieldCount()).To(Equal(1))
+			size := properties.Field(0)
+			g.Expect(size.FieldName()).To(Equal(astmodel.FieldName("Size")))
+			return
+		}
+	}
+
+	t.Fatal("WidgetProperties was not generated")
+}
+
+func Test_ToNodes_GivenNoResourceFilters_KeepsFieldsOfResources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewStringLoader(resourcesSchema))
+	g.Expect(err).To(BeNil())
+
+	scanner := NewSchemaScanner(astmodel.NewIdentifierFactory())
+	_, err = scanner.ToNodes(context.TODO(), schema.Root())
+	g.Expect(err).To(BeNil())
+
+	for name, def := range scanner.Definitions {
+		if name.Name() == "WidgetProperties" {
+			g.Expect(def.(*astmodel.StructDefinition).FieldCount()).To(Equal(3))
+			return
+		}
+	}
+
+	t.Fatal("WidgetProperties was not generated")
+}
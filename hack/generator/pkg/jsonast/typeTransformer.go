@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package jsonast
+
+import (
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"sort"
+
+	"github.com/Azure/k8s-infra/hack/generator/pkg/astmodel"
+)
+
+// A TypeTransformer renames the types it selects, or renames or overrides the type of one of their fields
+type TypeTransformer struct {
+	// Group is a wildcard matching specifier for which groups are selected by this transformer
+	Group      string `yaml:",omitempty"`
+	groupRegex *regexp.Regexp
+	// Version is a wildcard matching specifier for which versions are selected by this transformer
+	Version      string `yaml:",omitempty"`
+	versionRegex *regexp.Regexp
+	// Name is a wildcard matching specifier for which types are selected by this transformer
+	Name      string `yaml:",omitempty"`
+	nameRegex *regexp.Regexp
+	// Field is the Go name of the field to transform; if empty, the selected types themselves are renamed
+	Field string `yaml:",omitempty"`
+	// RenameTo is the new Go name of the type or field
+	RenameTo string `yaml:",omitempty"`
+	// Target is the type to give the field in place of its own
+	Target *TargetType `yaml:",omitempty"`
+	// Tags are struct tags to add to the field, such as the group and kind of a KnownTypeReference
+	Tags map[string]string `yaml:",omitempty"`
+	// Because is used to articulate why the transformer applied to a type (used to generate explanatory logs)
+	Because string
+}
+
+// A TargetType identifies the type a field is given by a TypeTransformer
+type TargetType struct {
+	// Package is the import path of the package declaring the type; if empty, Name must be a Go primitive type
+	Package string `yaml:",omitempty"`
+	// Alias is the name to import the package as, if other than the last element of its path
+	Alias string `yaml:",omitempty"`
+	// Name is the name of the type
+	Name string
+}
+
+var primitiveTypes = map[string]*astmodel.PrimitiveType{
+	"int":         astmodel.IntType,
+	"string":      astmodel.StringType,
+	"float64":     astmodel.FloatType,
+	"bool":        astmodel.BoolType,
+	"interface{}": astmodel.AnyType,
+}
+
+// Validate checks the transformer describes a change we can make
+func (transformer *TypeTransformer) Validate() error {
+	if transformer.Field == "" {
+		if transformer.RenameTo == "" {
+			return fmt.Errorf("transformer of type %s has nothing to rename it to", transformer.Name)
+		}
+
+		if transformer.Target != nil || len(transformer.Tags) > 0 {
+			return fmt.Errorf("transformer of type %s may only rename it, as it selects no field", transformer.Name)
+		}
+
+		return nil
+	}
+
+	if transformer.RenameTo == "" && transformer.Target == nil && len(transformer.Tags) == 0 {
+		return fmt.Errorf("transformer of field %s of type %s makes no change", transformer.Field, transformer.Name)
+	}
+
+	if transformer.Target != nil {
+		if _, err := transformer.Target.asType(); err != nil {
+			return fmt.Errorf("transformer of field %s of type %s has invalid target: %w", transformer.Field, transformer.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// AppliesToType indicates whether this transformer should be applied to the supplied type definition
+func (transformer *TypeTransformer) AppliesToType(definition astmodel.Definition) bool {
+	return transformer.matches(transformer.Group, &transformer.groupRegex, definition.Reference().GroupName()) &&
+		transformer.matches(transformer.Version, &transformer.versionRegex, definition.Reference().PackageName()) &&
+		transformer.matches(transformer.Name, &transformer.nameRegex, definition.Reference().Name())
+}
+
+func (transformer *TypeTransformer) matches(glob string, regex **regexp.Regexp, name string) bool {
+	if glob == "" {
+		return true
+	}
+
+	if *regex == nil {
+		*regex = createGlobbingRegex(glob)
+	}
+
+	return (*regex).MatchString(name)
+}
+
+// transformField applies this transformer to the given field
+func (transformer *TypeTransformer) transformField(field *astmodel.FieldDefinition) *astmodel.FieldDefinition {
+	result := field
+	if transformer.RenameTo != "" {
+		result = result.WithName(astmodel.FieldName(transformer.RenameTo))
+	}
+
+	if transformer.Target != nil {
+		// Validate ensures the target is valid
+		target, _ := transformer.Target.asType()
+		result = result.WithElementType(target)
+	}
+
+	// add tags in a stable order
+	var keys []string
+	for key := range transformer.Tags {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		result = result.WithTag(key, transformer.Tags[key])
+	}
+
+	return result
+}
+
+func (target *TargetType) asType() (astmodel.Type, error) {
+	if target.Name == "" {
+		return nil, fmt.Errorf("no type name given")
+	}
+
+	if target.Package == "" {
+		if primitive, ok := primitiveTypes[target.Name]; ok {
+			return primitive, nil
+		}
+
+		return nil, fmt.Errorf("%s is not a primitive type, so its package must be given", target.Name)
+	}
+
+	pkg := astmodel.NewLibraryPackageReference(target.Package)
+	if target.Alias != "" && target.Alias != path.Base(target.Package) {
+		pkg = astmodel.NewAliasedLibraryPackageReference(target.Package, target.Alias)
+	}
+
+	name := astmodel.NewDefinitionName(pkg, target.Name)
+	return &name, nil
+}
+
+// transformDefinitions applies the given transformers to the definitions, transforming fields before renaming types
+// so that every transformer selects types by their original names
+func transformDefinitions(transformers []*TypeTransformer, definitions []astmodel.Definition) ([]astmodel.Definition, error) {
+	for _, transformer := range transformers {
+		if err := transformer.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	applied := make(map[*TypeTransformer]bool)
+	renames := make(map[astmodel.DefinitionName]string)
+	var result []astmodel.Definition
+	for _, def := range definitions {
+		for _, transformer := range transformers {
+			if !transformer.AppliesToType(def) {
+				continue
+			}
+
+			if transformer.Field == "" {
+				log.Printf("Renaming %s to %s because %s\n", def.Reference().Name(), transformer.RenameTo, transformer.Because)
+				renames[*def.Reference()] = transformer.RenameTo
+				applied[transformer] = true
+				continue
+			}
+
+			structDef, ok := def.(*astmodel.StructDefinition)
+			if !ok {
+				continue
+			}
+
+			var fields []*astmodel.FieldDefinition
+			for _, field := range structDef.Fields() {
+				if string(field.FieldName()) == transformer.Field {
+					log.Printf("Transforming %s.%s because %s\n", def.Reference().Name(), field.FieldName(), transformer.Because)
+					field = transformer.transformField(field)
+					applied[transformer] = true
+				}
+
+				fields = append(fields, field)
+			}
+
+			def = structDef.WithFields(fields...)
+		}
+
+		result = append(result, def)
+	}
+
+	for _, transformer := range transformers {
+		if !applied[transformer] {
+			log.Printf("WRN Transformer of %s %s applied to nothing\n", transformer.Name, transformer.Field)
+		}
+	}
+
+	return astmodel.RenameDefinitions(result, renames), nil
+}
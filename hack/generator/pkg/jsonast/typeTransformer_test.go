@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package jsonast
+
+import (
+	"bytes"
+	"go/format"
+	"go/token"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/Azure/k8s-infra/hack/generator/pkg/astmodel"
+)
+
+func Test_Transform_GivenFieldTransformer_RenamesFieldAndOverridesType(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	properties := astmodel.NewStructDefinition(
+		astmodel.NewStructReference("VirtualNetworkLinkProperties", "microsoft.network", "v20200101", false),
+		astmodel.NewFieldDefinition("VirtualNetwork", "virtualNetwork", astmodel.NewOptionalType(astmodel.StringType)).
+			WithValidation(astmodel.ValidatePattern("^/subscriptions/.*")))
+
+	config := ExportConfiguration{
+		TypeTransformers: []*TypeTransformer{
+			{
+				Name:     "VirtualNetworkLinkProperties",
+				Field:    "VirtualNetwork",
+				RenameTo: "VirtualNetworkRef",
+				Target: &TargetType{
+					Package: "github.com/Azure/k8s-infra/apis/core/v1",
+					Alias:   "azcorev1",
+					Name:    "KnownTypeReference",
+				},
+				Tags: map[string]string{
+					"kind":  "VirtualNetwork",
+					"group": "microsoft.network.infra.azure.com",
+				},
+				Because: "the virtual network is managed within the cluster",
+			},
+		},
+	}
+
+	transformed, err := config.Transform([]astmodel.Definition{properties})
+	g.Expect(err).To(BeNil())
+	g.Expect(transformed).To(HaveLen(1))
+
+	buf := &bytes.Buffer{}
+	g.Expect(format.Node(buf, token.NewFileSet(), transformed[0].AsDeclarations())).To(Succeed())
+	g.Expect(buf.String()).To(ContainSubstring(
+		"VirtualNetworkRef *azcorev1.KnownTypeReference " +
+			"`json:\"virtualNetwork\" group:\"microsoft.network.infra.azure.com\" kind:\"VirtualNetwork\"`"))
+
+	// the pattern of the string can't apply to the reference replacing it
+	g.Expect(buf.String()).NotTo(ContainSubstring("Pattern"))
+}
+
+func Test_Transform_GivenTypeTransformer_RenamesTypeAndReferences(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	format := astmodel.NewStructReference("WidgetPropertiesFormat", "microsoft.test", "v20200101", false)
+	widget := astmodel.NewStructDefinition(
+		astmodel.NewStructReference("Widget", "microsoft.test", "v20200101", true),
+		astmodel.NewFieldDefinition("Properties", "properties", &format))
+
+	config := ExportConfiguration{
+		TypeTransformers: []*TypeTransformer{
+			{Name: "*PropertiesFormat", RenameTo: "WidgetProperties", Because: "Format adds nothing"},
+		},
+	}
+
+	transformed, err := config.Transform([]astmodel.Definition{widget, astmodel.NewStructDefinition(format)})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(transformed[1].Reference().Name()).To(Equal("WidgetProperties"))
+	g.Expect(transformed[0].Type().References(transformed[1].Reference())).To(BeTrue())
+}
+
+func Test_TypeTransformerValidate_GivenInvalidTransformer_ReturnsError(t *testing.T) {
+	cases := []struct {
+		name        string
+		transformer TypeTransformer
+	}{
+		{"Type without new name", TypeTransformer{Name: "Widget"}},
+		{"Type with target", TypeTransformer{Name: "Widget", RenameTo: "Gadget", Target: &TargetType{Name: "string"}}},
+		{"Field without change", TypeTransformer{Name: "Widget", Field: "Size"}},
+		{"Field with unknown primitive", TypeTransformer{Name: "Widget", Field: "Size", Target: &TargetType{Name: "int32"}}},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(c.transformer.Validate()).NotTo(Succeed())
+		})
+	}
+}
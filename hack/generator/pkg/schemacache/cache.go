@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package schemacache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xeipuuv/gojsonreference"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Cache is a directory holding local copies of JSON schemas, laid out by the host and path of the URL each was
+// downloaded from, so that schemas can be loaded without network access
+type Cache struct {
+	dir string
+}
+
+// Cache must implement the JSONLoaderFactory interface so that references between schemas are also loaded from it
+var _ gojsonschema.JSONLoaderFactory = (*Cache)(nil)
+
+// NewCache returns a cache of the schemas held within the given directory
+func NewCache(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// PathFor returns the file in the cache which holds the schema downloaded from the given URL
+func (cache *Cache) PathFor(source string) (string, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return "", fmt.Errorf("parsing schema URL %s with: %w", source, err)
+	}
+
+	if u.Host == "" || u.Path == "" {
+		return "", fmt.Errorf("schema URL %s has no host or path", source)
+	}
+
+	// ports are kept apart from the host, as colons are not valid in file names everywhere
+	host := strings.Replace(u.Host, ":", "_", -1)
+	path := filepath.FromSlash(strings.TrimPrefix(u.Path, "/"))
+	for _, segment := range strings.Split(path, string(filepath.Separator)) {
+		if segment == ".." {
+			return "", fmt.Errorf("schema URL %s must not refer to a parent directory", source)
+		}
+	}
+
+	return filepath.Join(cache.dir, host, path), nil
+}
+
+// New returns a loader which reads the given schema from the cache; it implements JSONLoaderFactory
+func (cache *Cache) New(source string) gojsonschema.JSONLoader {
+	return &cachedLoader{cache: cache, source: source}
+}
+
+// Fetch downloads the schema at the given URL, along with every schema it references directly or indirectly, into
+// the cache, replacing any copies already there
+func (cache *Cache) Fetch(ctx context.Context, source string) error {
+	pending := []string{withoutFragment(source)}
+	seen := map[string]bool{pending[0]: true}
+	for len(pending) > 0 {
+		address := pending[0]
+		pending = pending[1:]
+
+		references, err := cache.fetchOne(ctx, address)
+		if err != nil {
+			return err
+		}
+
+		for _, reference := range references {
+			if !seen[reference] {
+				seen[reference] = true
+				pending = append(pending, reference)
+			}
+		}
+	}
+
+	log.Printf("Fetched %d schemas into %s\n", len(seen), cache.dir)
+	return nil
+}
+
+// fetchOne downloads a single schema into the cache, returning the schemas it references
+func (cache *Cache) fetchOne(ctx context.Context, address string) ([]string, error) {
+	path, err := cache.PathFor(address)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request for schema %s with: %w", address, err)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("downloading schema %s with: %w", address, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading schema %s failed with status %s", address, response.Status)
+	}
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading schema %s with: %w", address, err)
+	}
+
+	document, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing schema %s with: %w", address, err)
+	}
+
+	references, err := findReferences(address, document)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, fmt.Errorf("creating cache directory for schema %s with: %w", address, err)
+	}
+
+	err = ioutil.WriteFile(path, data, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("writing schema %s to the cache with: %w", address, err)
+	}
+
+	return references, nil
+}
+
+// findReferences returns the schemas referenced by $ref anywhere within the given document, resolved against the URL
+// of the document; references within the document itself and to local files are omitted
+func findReferences(address string, document interface{}) ([]string, error) {
+	base, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("parsing schema URL %s with: %w", address, err)
+	}
+
+	self := withoutFragment(address)
+	var result []string
+	var find func(node interface{}) error
+	find = func(node interface{}) error {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			for key, value := range n {
+				if ref, ok := value.(string); ok && key == "$ref" {
+					u, err := base.Parse(ref)
+					if err != nil {
+						return fmt.Errorf("parsing reference %s in schema %s with: %w", ref, address, err)
+					}
+
+					target := withoutFragment(u.String())
+					if (u.Scheme == "http" || u.Scheme == "https") && target != self {
+						result = append(result, target)
+					}
+
+					continue
+				}
+
+				if err := find(value); err != nil {
+					return err
+				}
+			}
+
+		case []interface{}:
+			for _, value := range n {
+				if err := find(value); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := find(document); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// cachedLoader loads a schema from the cache while keeping the URL it was downloaded from as its reference, so that
+// the identities of the schemas are the same as when they are loaded from the network
+type cachedLoader struct {
+	cache  *Cache
+	source string
+}
+
+var _ gojsonschema.JSONLoader = (*cachedLoader)(nil)
+
+func (loader *cachedLoader) JsonSource() interface{} {
+	return loader.source
+}
+
+func (loader *cachedLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference(loader.source)
+}
+
+func (loader *cachedLoader) LoaderFactory() gojsonschema.JSONLoaderFactory {
+	return loader.cache
+}
+
+func (loader *cachedLoader) LoadJSON() (interface{}, error) {
+	address := withoutFragment(loader.source)
+	if strings.HasPrefix(address, "file://") {
+		return gojsonschema.NewReferenceLoader(address).LoadJSON()
+	}
+
+	path, err := loader.cache.PathFor(address)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("schema %s is not in the cache %s; run fetch to download it", address, loader.cache.dir)
+	} else if err != nil {
+		return nil, fmt.Errorf("reading schema %s from the cache with: %w", address, err)
+	}
+
+	document, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing schema %s with: %w", address, err)
+	}
+
+	return document, nil
+}
+
+// decodeJSON decodes numbers as json.Number, as gojsonschema expects
+func decodeJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func withoutFragment(address string) string {
+	if i := strings.Index(address, "#"); i >= 0 {
+		return address[:i]
+	}
+
+	return address
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) Microsoft Corporation.
+ * Licensed under the MIT license.
+ */
+
+package schemacache
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const rootURL = "https://schema.example.com/schemas/2020-01-01/root.json"
+
+func Test_PathFor_GivenURL_ReturnsPathByHostAndPath(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path, err := NewCache("cache").PathFor("http://localhost:8080/schemas/root.json#/definitions/Name")
+
+	g.Expect(err).To(BeNil())
+	g.Expect(path).To(Equal(filepath.Join("cache", "localhost_8080", "schemas", "root.json")))
+}
+
+func Test_PathFor_GivenParentDirectory_ReturnsError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := NewCache("cache").PathFor("https://schema.example.com/schemas/../../secret.json")
+
+	g.Expect(err).NotTo(BeNil())
+}
+
+func Test_Compile_GivenCachedSchemas_LoadsReferencesFromCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schema, err := gojsonschema.NewSchemaLoader().Compile(NewCache("testdata").New(rootURL))
+	g.Expect(err).To(BeNil())
+
+	root := schema.Root()
+	g.Expect(root.ID.String()).To(Equal(rootURL))
+
+	// the referenced schema keeps the URL it was downloaded from
+	var widget *gojsonschema.SubSchema
+	for _, property := range root.PropertiesChildren {
+		if property.Property == "widget" {
+			widget = property.RefSchema
+		}
+	}
+
+	g.Expect(widget).NotTo(BeNil())
+	g.Expect(widget.ID.String()).To(Equal("https://schema.example.com/schemas/2020-01-01/Microsoft.Test.json#/definitions/Widget"))
+}
+
+func Test_Compile_GivenSchemaMissingFromCache_ReturnsError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := gojsonschema.NewSchemaLoader().Compile(NewCache("testdata").New("https://schema.example.com/missing.json"))
+
+	g.Expect(err).NotTo(BeNil())
+	g.Expect(err.Error()).To(ContainSubstring("run fetch"))
+}
+
+func Test_Fetch_GivenSchemaWithReferences_DownloadsReferencedSchemas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schemas := map[string]string{
+		"/schemas/root.json": `{
+			"properties": {
+				"widget": { "$ref": "Microsoft.Test.json#/definitions/Widget" },
+				"name": { "$ref": "#/definitions/Name" }
+			},
+			"definitions": { "Name": { "type": "string" } }
+		}`,
+		"/schemas/Microsoft.Test.json": `{
+			"definitions": { "Widget": { "type": "object" } }
+		}`,
+	}
+
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		if schema, ok := schemas[r.URL.Path]; ok {
+			_, _ = w.Write([]byte(schema))
+		} else {
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "schemacache")
+	g.Expect(err).To(BeNil())
+	defer os.RemoveAll(dir)
+
+	cache := NewCache(dir)
+	err = cache.Fetch(context.Background(), server.URL+"/schemas/root.json")
+
+	g.Expect(err).To(BeNil())
+	g.Expect(requested).To(Equal([]string{"/schemas/root.json", "/schemas/Microsoft.Test.json"}))
+	for path := range schemas {
+		cached, err := cache.PathFor(server.URL + path)
+		g.Expect(err).To(BeNil())
+		g.Expect(cached).To(BeAnExistingFile())
+	}
+}
+
+func Test_Fetch_GivenMissingSchema_ReturnsError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	err := NewCache("unused").Fetch(context.Background(), server.URL+"/schemas/root.json")
+
+	g.Expect(err).NotTo(BeNil())
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package test
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+)
+
+const fuzzIterations = 100
+
+// FuzzConversion converts fuzzed hubs to the spoke and back again, and fuzzed spokes to the hub and back again,
+// failing the test if either round trip changes the object
+func FuzzConversion(t *testing.T, hub conversion.Hub, spoke conversion.Convertible) {
+	fuzzer := fuzz.New().NilChance(0.2).NumElements(0, 3).Funcs(
+		// the type meta is set from the scheme rather than converted
+		func(_ *metav1.TypeMeta, _ fuzz.Continue) {},
+	)
+
+	t.Run("hub-spoke-hub", func(t *testing.T) {
+		for i := 0; i < fuzzIterations; i++ {
+			hubBefore := hub.DeepCopyObject().(conversion.Hub)
+			fuzzer.Fuzz(hubBefore)
+
+			spokeAfter := spoke.DeepCopyObject().(conversion.Convertible)
+			if err := spokeAfter.ConvertFrom(hubBefore); err != nil {
+				t.Fatalf("failed converting from hub with: %v", err)
+			}
+
+			hubAfter := hub.DeepCopyObject().(conversion.Hub)
+			if err := spokeAfter.ConvertTo(hubAfter); err != nil {
+				t.Fatalf("failed converting to hub with: %v", err)
+			}
+
+			if !apiequality.Semantic.DeepEqual(hubBefore, hubAfter) {
+				t.Fatalf("hub changed on round trip: %s", diff.ObjectReflectDiff(hubBefore, hubAfter))
+			}
+		}
+	})
+
+	t.Run("spoke-hub-spoke", func(t *testing.T) {
+		for i := 0; i < fuzzIterations; i++ {
+			spokeBefore := spoke.DeepCopyObject().(conversion.Convertible)
+			fuzzer.Fuzz(spokeBefore)
+
+			hubAfter := hub.DeepCopyObject().(conversion.Hub)
+			if err := spokeBefore.ConvertTo(hubAfter); err != nil {
+				t.Fatalf("failed converting to hub with: %v", err)
+			}
+
+			spokeAfter := spoke.DeepCopyObject().(conversion.Convertible)
+			if err := spokeAfter.ConvertFrom(hubAfter); err != nil {
+				t.Fatalf("failed converting from hub with: %v", err)
+			}
+
+			// the spoke gains the data of the hub, which the original spoke did not have
+			if metaObj, ok := spokeAfter.(metav1.Object); ok {
+				azcorev1.RemoveConversionData(metaObj)
+			}
+
+			if !apiequality.Semantic.DeepEqual(spokeBefore, spokeAfter) {
+				t.Fatalf("spoke changed on round trip: %s", diff.ObjectReflectDiff(spokeBefore, spokeAfter))
+			}
+		}
+	})
+}
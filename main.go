@@ -7,9 +7,13 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/klog/v2"
@@ -17,11 +21,19 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	microsoftcomputev1 "github.com/Azure/k8s-infra/apis/microsoft.compute/v1"
+	microsoftcomputev20191201 "github.com/Azure/k8s-infra/apis/microsoft.compute/v20191201"
+	microsoftkeyvaultv1 "github.com/Azure/k8s-infra/apis/microsoft.keyvault/v1"
+	microsoftkeyvaultv20190901 "github.com/Azure/k8s-infra/apis/microsoft.keyvault/v20190901"
 	microsoftnetworkv1 "github.com/Azure/k8s-infra/apis/microsoft.network/v1"
+	microsoftnetworkv20180901 "github.com/Azure/k8s-infra/apis/microsoft.network/v20180901"
 	microsoftnetworkv20191101 "github.com/Azure/k8s-infra/apis/microsoft.network/v20191101"
 	microsoftresourcesv1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
 	microsoftresourcesv20150101 "github.com/Azure/k8s-infra/apis/microsoft.resources/v20150101"
 	microsoftresourcesv20191001 "github.com/Azure/k8s-infra/apis/microsoft.resources/v20191001"
+	microsoftstoragev1 "github.com/Azure/k8s-infra/apis/microsoft.storage/v1"
+	microsoftstoragev20190601 "github.com/Azure/k8s-infra/apis/microsoft.storage/v20190601"
 	"github.com/Azure/k8s-infra/controllers"
 	"github.com/Azure/k8s-infra/pkg/zips"
 	// +kubebuilder:scaffold:imports
@@ -40,16 +52,29 @@ func init() {
 	_ = microsoftresourcesv20150101.AddToScheme(scheme)
 	_ = microsoftresourcesv1.AddToScheme(scheme)
 	_ = microsoftnetworkv20191101.AddToScheme(scheme)
+	_ = microsoftnetworkv20180901.AddToScheme(scheme)
 	_ = microsoftnetworkv1.AddToScheme(scheme)
+	_ = microsoftcomputev20191201.AddToScheme(scheme)
+	_ = microsoftcomputev1.AddToScheme(scheme)
+	_ = microsoftstoragev20190601.AddToScheme(scheme)
+	_ = microsoftstoragev1.AddToScheme(scheme)
+	_ = microsoftkeyvaultv20190901.AddToScheme(scheme)
+	_ = microsoftkeyvaultv1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
 func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
+	var defaultTagsConfigMap string
+	var deletionProtectedKinds string
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&defaultTagsConfigMap, "default-tags-configmap", "",
+		"The namespace/name of a ConfigMap whose data are tags added to every Azure resource which does not already set them.")
+	flag.StringVar(&deletionProtectedKinds, "deletion-protected-kinds", "",
+		"A comma separated list of Kind.group, eg. VirtualNetwork.microsoft.network.infra.azure.com, which may not be deleted unless annotated to allow it.")
 	flag.Parse()
 
 	ctrl.SetLogger(klogr.New())
@@ -66,6 +91,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	tagsConfigMap, err := parseNamespacedName(defaultTagsConfigMap)
+	if err != nil {
+		setupLog.Error(err, "invalid default-tags-configmap")
+		os.Exit(1)
+	}
+	// the webhooks read through the cache of the manager, so admission does not call the API server for each lookup
+	azcorev1.ConfigureWebhooks(mgr.GetClient(), tagsConfigMap)
+
+	azcorev1.ConfigureDeletionProtection(parseGroupKinds(deletionProtectedKinds))
+
 	applier, err := zips.NewAzureTemplateClient()
 	if err != nil {
 		setupLog.Error(err, "failed to create zips Applier.")
@@ -91,3 +126,28 @@ func main() {
 func concurrency(c int) controller.Options {
 	return controller.Options{MaxConcurrentReconciles: c}
 }
+
+// parseNamespacedName parses a value of the form namespace/name; an empty value is allowed and means unset
+func parseNamespacedName(value string) (types.NamespacedName, error) {
+	if value == "" {
+		return types.NamespacedName{}, nil
+	}
+
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return types.NamespacedName{}, fmt.Errorf("expected a value of the form namespace/name, but was %q", value)
+	}
+
+	return types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
+}
+
+// parseGroupKinds parses a comma separated list of Kind.group
+func parseGroupKinds(value string) []schema.GroupKind {
+	var kinds []schema.GroupKind
+	for _, kind := range strings.Split(value, ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			kinds = append(kinds, schema.ParseGroupKind(kind))
+		}
+	}
+	return kinds
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+// Package ipam allocates address prefixes from an address space
+package ipam
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+)
+
+// ErrAddressSpaceExhausted is returned when no free prefix of the requested length remains in the address space
+var ErrAddressSpaceExhausted = errors.New("no free prefix of the requested length remains in the address space")
+
+// Allocate returns the lowest prefix of the given length within the address space which does not overlap any of the
+// used prefixes. Given the same inputs, the same prefix is always returned, so allocations only depend upon which
+// prefixes are already in use.
+func Allocate(space []*net.IPNet, used []*net.IPNet, prefixLength int) (*net.IPNet, error) {
+	for _, spaceNet := range space {
+		spaceOnes, bits := spaceNet.Mask.Size()
+		if prefixLength < spaceOnes || prefixLength > bits {
+			continue
+		}
+
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+		spaceEnd := new(big.Int).Add(ipToInt(spaceNet.IP), new(big.Int).Lsh(big.NewInt(1), uint(bits-spaceOnes)))
+		candidate := ipToInt(spaceNet.IP)
+		for new(big.Int).Add(candidate, size).Cmp(spaceEnd) <= 0 {
+			candidateNet := &net.IPNet{
+				IP:   intToIP(candidate, bits),
+				Mask: net.CIDRMask(prefixLength, bits),
+			}
+
+			overlapping := firstOverlap(candidateNet, used)
+			if overlapping == nil {
+				return candidateNet, nil
+			}
+
+			// skip past the used prefix to the next boundary of the requested length
+			candidate = alignUp(lastIP(overlapping), size)
+		}
+	}
+
+	return nil, ErrAddressSpaceExhausted
+}
+
+// ParseCIDRs parses each of the prefixes
+func ParseCIDRs(prefixes []string) ([]*net.IPNet, error) {
+	ipNets := make([]*net.IPNet, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		_, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse prefix %q with: %w", prefix, err)
+		}
+		ipNets = append(ipNets, ipNet)
+	}
+	return ipNets, nil
+}
+
+func firstOverlap(ipNet *net.IPNet, others []*net.IPNet) *net.IPNet {
+	for _, other := range others {
+		if (other.IP.To4() == nil) != (ipNet.IP.To4() == nil) {
+			continue
+		}
+
+		if ipNet.Contains(other.IP) || other.Contains(ipNet.IP) {
+			return other
+		}
+	}
+	return nil
+}
+
+// alignUp returns the first multiple of size greater than ip
+func alignUp(ip *big.Int, size *big.Int) *big.Int {
+	next := new(big.Int).Add(ip, big.NewInt(1))
+	remainder := new(big.Int).Mod(next, size)
+	if remainder.Sign() == 0 {
+		return next
+	}
+	return next.Add(next, new(big.Int).Sub(size, remainder))
+}
+
+func lastIP(ipNet *net.IPNet) *big.Int {
+	ones, bits := ipNet.Mask.Size()
+	hostCount := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	return hostCount.Add(hostCount, ipToInt(ipNet.IP)).Sub(hostCount, big.NewInt(1))
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	if ip4 := ip.To4(); ip4 != nil {
+		return new(big.Int).SetBytes(ip4)
+	}
+	return new(big.Int).SetBytes(ip.To16())
+}
+
+func intToIP(i *big.Int, bits int) net.IP {
+	ip := make(net.IP, bits/8)
+	i.FillBytes(ip)
+	return ip
+}
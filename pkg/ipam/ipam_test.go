@@ -0,0 +1,92 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package ipam
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestAllocate(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Space        []string
+		Used         []string
+		PrefixLength int
+		Expected     string
+		ExpectErr    error
+	}{
+		{
+			Name:         "Empty",
+			Space:        []string{"10.0.0.0/16"},
+			PrefixLength: 24,
+			Expected:     "10.0.0.0/24",
+		},
+		{
+			Name:         "SkipsUsed",
+			Space:        []string{"10.0.0.0/16"},
+			Used:         []string{"10.0.0.0/24", "10.0.1.0/25"},
+			PrefixLength: 24,
+			Expected:     "10.0.2.0/24",
+		},
+		{
+			Name:         "FillsGap",
+			Space:        []string{"10.0.0.0/16"},
+			Used:         []string{"10.0.0.0/26", "10.0.0.128/25"},
+			PrefixLength: 26,
+			Expected:     "10.0.0.64/26",
+		},
+		{
+			Name:         "SkipsLargerUsed",
+			Space:        []string{"10.0.0.0/16"},
+			Used:         []string{"10.0.0.0/20"},
+			PrefixLength: 28,
+			Expected:     "10.0.16.0/28",
+		},
+		{
+			Name:         "NextSpace",
+			Space:        []string{"10.0.0.0/24", "10.1.0.0/16"},
+			Used:         []string{"10.0.0.0/25"},
+			PrefixLength: 24,
+			Expected:     "10.1.0.0/24",
+		},
+		{
+			Name:         "IPv6",
+			Space:        []string{"fd00::/48"},
+			Used:         []string{"fd00::/64"},
+			PrefixLength: 64,
+			Expected:     "fd00:0:0:1::/64",
+		},
+		{
+			Name:         "Exhausted",
+			Space:        []string{"10.0.0.0/24"},
+			Used:         []string{"10.0.0.0/25", "10.0.0.128/26", "10.0.0.192/26"},
+			PrefixLength: 26,
+			ExpectErr:    ErrAddressSpaceExhausted,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			space, err := ParseCIDRs(c.Space)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			used, err := ParseCIDRs(c.Used)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+
+			allocated, err := Allocate(space, used, c.PrefixLength)
+			if c.ExpectErr != nil {
+				g.Expect(err).To(gomega.Equal(c.ExpectErr))
+				return
+			}
+
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(allocated.String()).To(gomega.Equal(c.Expected))
+		})
+	}
+}
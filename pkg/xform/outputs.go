@@ -0,0 +1,68 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package xform
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	"github.com/Azure/k8s-infra/pkg/zips"
+)
+
+// ResourceOutputs evaluates the JSONPath expressions of each output value against the ARM resource and returns the
+// results keyed by the output value keys
+func ResourceOutputs(res *zips.Resource, values []azcorev1.OutputValue) (map[string]string, error) {
+	bits, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal resource with: %w", err)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(bits, &data); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal resource with: %w", err)
+	}
+
+	// the etag is not part of the template resource, but is useful to export
+	if res.ETag != "" {
+		data["etag"] = res.ETag
+	}
+
+	outputs := make(map[string]string, len(values))
+	for _, value := range values {
+		jp := jsonpath.New(value.Key)
+		if err := jp.Parse(relaxedJSONPathExpression(value.JSONPath)); err != nil {
+			return nil, fmt.Errorf("unable to parse JSONPath %q for output %q with: %w", value.JSONPath, value.Key, err)
+		}
+
+		var buf bytes.Buffer
+		if err := jp.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("unable to evaluate JSONPath %q for output %q with: %w", value.JSONPath, value.Key, err)
+		}
+
+		outputs[value.Key] = buf.String()
+	}
+
+	return outputs, nil
+}
+
+// relaxedJSONPathExpression allows expressions to be written as either {.properties.foo} or .properties.foo
+func relaxedJSONPathExpression(expr string) string {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "{") {
+		return expr
+	}
+
+	if !strings.HasPrefix(expr, ".") {
+		expr = "." + expr
+	}
+
+	return "{" + expr + "}"
+}
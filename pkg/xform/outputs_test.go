@@ -0,0 +1,54 @@
+/*
+Copyright (c) Microsoft Corporation.
+Licensed under the MIT license.
+*/
+
+package xform
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
+	"github.com/Azure/k8s-infra/pkg/zips"
+)
+
+func TestResourceOutputs(t *testing.T) {
+	res := &zips.Resource{
+		ID:         "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/loadBalancers/lb/frontendIPConfigurations/fe",
+		Name:       "lb/fe",
+		ETag:       `W/"etag"`,
+		Properties: []byte(`{"privateIPAddress":"10.0.0.4","subnet":{"id":"subnet1"}}`),
+	}
+
+	outputs, err := ResourceOutputs(res, []azcorev1.OutputValue{
+		{Key: "id", JSONPath: "{.id}"},
+		{Key: "ip", JSONPath: ".properties.privateIPAddress"},
+		{Key: "subnet", JSONPath: "properties.subnet.id"},
+		{Key: "etag", JSONPath: "{.etag}"},
+	})
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(outputs).To(gomega.Equal(map[string]string{
+		"id":     res.ID,
+		"ip":     "10.0.0.4",
+		"subnet": "subnet1",
+		"etag":   res.ETag,
+	}))
+}
+
+func TestResourceOutputs_MissingValue(t *testing.T) {
+	res := &zips.Resource{
+		ID:         "id",
+		Properties: []byte(`{}`),
+	}
+
+	_, err := ResourceOutputs(res, []azcorev1.OutputValue{
+		{Key: "ip", JSONPath: "{.properties.privateIPAddress}"},
+	})
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 )
@@ -25,13 +26,57 @@ type (
 	}
 )
 
+var (
+	// typeReferenceCache holds the type reference locations of each type, so struct tags are only walked once per type
+	typeReferenceCache    sync.Map
+	allTypeReferenceCache sync.Map
+)
+
 func GetTypeReferenceData(obj azcorev1.MetaObject) ([]TypeReferenceLocation, error) {
-	// expect the obj.Spec.Properties
+	return cachedTypeReferenceData(&typeReferenceCache, obj, getTypeReferenceData)
+}
+
+// GetAllTypeReferenceData returns the locations of all type references found within obj.Spec, including references
+// which live outside of obj.Spec.Properties, such as the ResourceGroupRef of a grouped resource
+func GetAllTypeReferenceData(obj azcorev1.MetaObject) ([]TypeReferenceLocation, error) {
+	return cachedTypeReferenceData(&allTypeReferenceCache, obj, getAllTypeReferenceData)
+}
+
+func cachedTypeReferenceData(cache *sync.Map, obj azcorev1.MetaObject, gather func(t reflect.Type) ([]TypeReferenceLocation, error)) ([]TypeReferenceLocation, error) {
 	t := reflect.TypeOf(obj)
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
 	}
 
+	if refs, ok := cache.Load(t); ok {
+		return copyTypeReferenceLocations(refs.([]TypeReferenceLocation)), nil
+	}
+
+	refs, err := gather(t)
+	if err != nil {
+		return refs, err
+	}
+
+	cache.Store(t, refs)
+	return copyTypeReferenceLocations(refs), nil
+}
+
+func copyTypeReferenceLocations(refs []TypeReferenceLocation) []TypeReferenceLocation {
+	if refs == nil {
+		return nil
+	}
+
+	refsCopy := make([]TypeReferenceLocation, len(refs))
+	for i, ref := range refs {
+		ref.Path = append([]string{}, ref.Path...)
+		refsCopy[i] = ref
+	}
+	return refsCopy
+}
+
+func getTypeReferenceData(t reflect.Type) ([]TypeReferenceLocation, error) {
+	// expect the obj.Spec.Properties
+
 	specField, found := t.FieldByName("Spec")
 	if !found {
 		return nil, fmt.Errorf("GetTypeReferenceData could not find obj.Spec field")
@@ -54,6 +99,24 @@ func GetTypeReferenceData(obj azcorev1.MetaObject) ([]TypeReferenceLocation, err
 	return refs, nil
 }
 
+func getAllTypeReferenceData(t reflect.Type) ([]TypeReferenceLocation, error) {
+	specField, found := t.FieldByName("Spec")
+	if !found {
+		return nil, fmt.Errorf("GetAllTypeReferenceData could not find obj.Spec field")
+	}
+
+	refs, err := getResourceReferences(specField.Type)
+	if err != nil {
+		return refs, err
+	}
+
+	for i := range refs {
+		refs[i].Path = append([]string{"spec"}, refs[i].Path...)
+	}
+
+	return refs, nil
+}
+
 func getResourceReferences(t reflect.Type) ([]TypeReferenceLocation, error) {
 	var refs []TypeReferenceLocation
 	var err error
@@ -126,7 +189,7 @@ func gatherStruct(t reflect.Type) ([]TypeReferenceLocation, error) {
 			}
 
 			for i := range references {
-				references[i].Path = append(references[i].Path, strings.Split(jsonTag, ",")[0])
+				references[i].Path = append([]string{jsonFieldName}, references[i].Path...)
 			}
 
 			refs = append(refs, references...)
@@ -136,9 +199,9 @@ func gatherStruct(t reflect.Type) ([]TypeReferenceLocation, error) {
 }
 
 func (trl *TypeReferenceLocation) JSONFields() []string {
-	return append(trl.Path, trl.JSONFieldName)
+	return append(append([]string{}, trl.Path...), trl.JSONFieldName)
 }
 
 func (trl *TypeReferenceLocation) TemplateFields() []string {
-	return append(trl.Path, trl.TemplateFieldName)
+	return append(append([]string{}, trl.Path...), trl.TemplateFieldName)
 }
@@ -72,6 +72,30 @@ func TestGetTypeReferenceData(t *testing.T) {
 	}))
 }
 
+func TestGetAllTypeReferenceData(t *testing.T) {
+	nn := &client.ObjectKey{
+		Namespace: "default",
+		Name:      test.RandomName("foo", 10),
+	}
+
+	refsData, err := GetAllTypeReferenceData(newLocalRouteTable(nn))
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(refsData).To(gomega.HaveLen(5))
+	g.Expect(refsData[0]).To(gomega.Equal(TypeReferenceLocation{
+		JSONFieldName:     "resourceGroupRef",
+		TemplateFieldName: "resourceGroup",
+		Path:              []string{"spec"},
+		Group:             "microsoft.resources.infra.azure.com",
+		Kind:              "ResourceGroup",
+		IsSlice:           false,
+	}))
+	g.Expect(refsData[1].JSONFields()).To(gomega.Equal([]string{"spec", "properties", "embeddedBazzRef"}))
+	g.Expect(refsData[2].JSONFields()).To(gomega.Equal([]string{"spec", "properties", "routeRefs"}))
+	g.Expect(refsData[3].JSONFields()).To(gomega.Equal([]string{"spec", "properties", "foo", "blahRefs"}))
+	g.Expect(refsData[4].JSONFields()).To(gomega.Equal([]string{"spec", "properties", "foo", "bazzRef"}))
+}
+
 func TestTypeReferenceLocation_JSONFields(t *testing.T) {
 	trl := &TypeReferenceLocation{
 		JSONFieldName:     "foo",
@@ -13,14 +13,17 @@ import (
 
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	azcorev1 "github.com/Azure/k8s-infra/apis/core/v1"
 	microsoftnetworkv1 "github.com/Azure/k8s-infra/apis/microsoft.network/v1"
 	microsoftresourcesv1 "github.com/Azure/k8s-infra/apis/microsoft.resources/v1"
+	microsoftstoragev1 "github.com/Azure/k8s-infra/apis/microsoft.storage/v1"
 	"github.com/Azure/k8s-infra/internal/test"
 	"github.com/Azure/k8s-infra/pkg/util/ownerutil"
 	"github.com/Azure/k8s-infra/pkg/zips"
@@ -104,6 +107,61 @@ func TestARMConverter_ToResource(t *testing.T) {
 	g.Expect(routeTableWithRouteIDs.APIVersion).To(gomega.Equal(routeTable.Spec.APIVersion))
 }
 
+func TestARMConverter_ToResource_FailsOnUnresolvedReferences(t *testing.T) {
+	randomName := test.RandomName("foo", 10)
+	nn := &client.ObjectKey{
+		Namespace: "default",
+		Name:      randomName,
+	}
+
+	vnet := &microsoftnetworkv1.VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nn.Name,
+			Namespace: nn.Namespace,
+		},
+		Spec: microsoftnetworkv1.VirtualNetworkSpec{
+			APIVersion: "2019-11-01",
+			Location:   "westus2",
+			ResourceGroupRef: &azcorev1.KnownTypeReference{
+				Name: nn.Name + "_rg",
+			},
+			Properties: &microsoftnetworkv1.VirtualNetworkSpecProperties{
+				AddressSpace: &microsoftnetworkv1.AddressSpaceSpec{
+					AddressPrefixes: []string{"10.0.0.0/16"},
+				},
+				SubnetRefs: []azcorev1.KnownTypeReference{
+					{
+						Name: nn.Name + "_subnet",
+					},
+				},
+			},
+		},
+	}
+
+	mc := new(MockClient)
+	mc.On("Get", mock.Anything, client.ObjectKey{
+		Namespace: nn.Namespace,
+		Name:      nn.Name + "_subnet",
+	}, new(microsoftnetworkv1.Subnet)).Return(apierrors.NewNotFound(schema.GroupResource{}, nn.Name+"_subnet"))
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = microsoftnetworkv1.AddToScheme(scheme)
+	converter := NewARMConverter(mc, scheme)
+	_, err := converter.ToResource(context.TODO(), vnet)
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(azcorev1.IsUnresolvedReference(err)).To(gomega.BeTrue())
+
+	// references are not resolved while deleting, as only the identity of the resource is needed
+	now := metav1.Now()
+	vnet.DeletionTimestamp = &now
+	res, err := converter.ToResource(context.TODO(), vnet)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(res.Name).To(gomega.Equal(vnet.Name))
+	g.Expect(res.ResourceGroup).To(gomega.Equal(vnet.Spec.ResourceGroupRef.Name))
+	g.Expect(res.Properties).To(gomega.BeEmpty())
+}
+
 func TestARMConverter_FromResource(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	scheme := runtime.NewScheme()
@@ -195,6 +253,30 @@ func Test_resourceName(t *testing.T) {
 	g.Expect(res.ResourceGroup).To(gomega.Equal(routeTable.GetResourceGroupObjectRef().Name))
 }
 
+func Test_resourceName_SingletonParent(t *testing.T) {
+	account := &microsoftstoragev1.StorageAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "sa"},
+		Spec: microsoftstoragev1.StorageAccountSpec{
+			ResourceGroupRef: &azcorev1.KnownTypeReference{Name: "rg"},
+		},
+	}
+	container := &microsoftstoragev1.BlobContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "data"},
+	}
+
+	g := gomega.NewGomegaWithT(t)
+	res := new(zips.Resource)
+	err := setOwnerInfluencedFields(res, container, ownerReferenceStates{
+		{
+			Obj:   account,
+			State: string(zips.SucceededProvisioningState),
+		},
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(res.Name).To(gomega.Equal("sa/default/data"))
+	g.Expect(res.ResourceGroup).To(gomega.Equal("rg"))
+}
+
 func Test_resourceTypeToParentTypesInOrder(t *testing.T) {
 	cases := []struct {
 		Name         string
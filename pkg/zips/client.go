@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -66,6 +67,13 @@ type (
 	NotFoundError struct {
 		Response *http.Response
 	}
+
+	// PreconditionFailedError is returned when Azure rejects a request because the If-Match ETag no longer matches the
+	// resource, which means the resource was changed since it was last read
+	PreconditionFailedError struct {
+		Body     string
+		Response *http.Response
+	}
 )
 
 var (
@@ -176,6 +184,77 @@ func (c *Client) GetResource(ctx context.Context, resourceID string, resource in
 	return nil
 }
 
+// PutResource will make an HTTP PUT call of the body to the resourceID and attempt to fill the resource with the
+// response
+func (c *Client) PutResource(ctx context.Context, resourceID string, body interface{}, resource interface{}, mw ...MiddlewareFunc) error {
+	bits, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.Put(ctx, resourceID, bytes.NewReader(bits), mw...)
+	defer closeResponse(ctx, res)
+
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusPreconditionFailed {
+		body, _ := ioutil.ReadAll(res.Body)
+		return &PreconditionFailedError{
+			Body:     string(body),
+			Response: res,
+		}
+	}
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode > 299 {
+		return NewHttpError(res, string(resBody))
+	}
+
+	if resource != nil && len(resBody) > 0 {
+		return json.Unmarshal(resBody, resource)
+	}
+
+	return nil
+}
+
+// PostResource will make an HTTP POST call to the resourceID, such as a resource action like listKeys, and attempt to
+// fill the resource with the response
+func (c *Client) PostResource(ctx context.Context, resourceID string, resource interface{}, mw ...MiddlewareFunc) error {
+	res, err := c.Post(ctx, resourceID, nil, mw...)
+	defer closeResponse(ctx, res)
+
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == 404 {
+		return &NotFoundError{
+			Response: res,
+		}
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode > 299 {
+		return NewHttpError(res, string(body))
+	}
+
+	if resource != nil && len(body) > 0 {
+		return json.Unmarshal(body, resource)
+	}
+
+	return nil
+}
+
 // DeleteResource will make an HTTP DELETE call to the resourceID and attempt to fill the resource with the response.
 // If the body of the response is empty, the resource will be nil.
 func (c *Client) DeleteResource(ctx context.Context, resourceID string, resource interface{}, mw ...MiddlewareFunc) error {
@@ -190,6 +269,14 @@ func (c *Client) DeleteResource(ctx context.Context, resourceID string, resource
 		return nil
 	}
 
+	if res.StatusCode == http.StatusPreconditionFailed {
+		body, _ := ioutil.ReadAll(res.Body)
+		return &PreconditionFailedError{
+			Body:     string(body),
+			Response: res,
+		}
+	}
+
 	if res.StatusCode > 299 {
 		// do our best to read the body, but if we can't, just carry on
 		body, _ := ioutil.ReadAll(res.Body)
@@ -335,6 +422,45 @@ func IsNotFound(err error) bool {
 	return ok
 }
 
+func (e PreconditionFailedError) Error() string {
+	var u *url.URL
+	if e.Response != nil && e.Response.Request != nil {
+		u = e.Response.Request.URL
+	}
+	return fmt.Sprintf("precondition failed uri: %s, status: %d, body: %s", u, http.StatusPreconditionFailed, e.Body)
+}
+
+// IsPreconditionFailed returns true if the error, or any error it wraps, is a PreconditionFailedError
+func IsPreconditionFailed(err error) bool {
+	var pfErr *PreconditionFailedError
+	return errors.As(err, &pfErr)
+}
+
+// withIfMatch sets the If-Match header so the request will only succeed if the resource still has the given ETag
+func withIfMatch(etag string) MiddlewareFunc {
+	return func(next RestHandler) RestHandler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if etag != "" {
+				req.Header.Set("If-Match", etag)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// withResponseETag captures the ETag header of the response
+func withResponseETag(etag *string) MiddlewareFunc {
+	return func(next RestHandler) RestHandler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			res, err := next(ctx, req)
+			if res != nil {
+				*etag = res.Header.Get("ETag")
+			}
+			return res, err
+		}
+	}
+}
+
 // WithAuthorization will inject the AZURE_TOKEN env var as the bearer token for API auth
 //
 // This is useful if you want to use a token from az cli.
@@ -78,6 +78,7 @@ type (
 		IsTemplateResource    *bool           `json:"isTemplateResource,omitempty"`
 		IsAction              *bool           `json:"isAction,omitempty"`
 		ProvisioningOperation string          `json:"provisioningOperation,omitempty"`
+		ETag                  string          `json:"etag,omitempty"`
 	}
 
 	TemplateOutput struct {
@@ -149,12 +150,18 @@ func (atc *AzureTemplateClient) GetResource(ctx context.Context, res *Resource)
 		return nil, fmt.Errorf("resource ID cannot be empty")
 	}
 
-	path := fmt.Sprintf("%s?api-version%s", res.ID, res.APIVersion)
-	err := atc.RawClient.GetResource(ctx, path, &res)
-	return res, err
+	path := fmt.Sprintf("%s?api-version=%s", res.ID, res.APIVersion)
+	var etag string
+	var body json.RawMessage
+	if err := atc.RawClient.GetResource(ctx, path, &body, withResponseETag(&etag)); err != nil {
+		return res, err
+	}
+
+	return res, fillResourceFromBody(body, etag, res)
 }
 
-// Apply deploys a resource to Azure via a deployment template
+// Apply deploys a resource to Azure via a deployment template. An existing resource which tracks ETags is PUT directly
+// instead, so the ETag it was last read with is checked by Azure as part of the write.
 func (atc *AzureTemplateClient) Apply(ctx context.Context, res *Resource) (*Resource, error) {
 	switch {
 	case res.ProvisioningState == DeletingProvisioningState:
@@ -165,6 +172,9 @@ func (atc *AzureTemplateClient) Apply(ctx context.Context, res *Resource) (*Reso
 	case res.DeploymentID != "":
 		// existing deployment is already going, so let's get an updated status
 		return atc.updateFromExistingDeployment(ctx, res)
+	case res.ProvisioningState != "":
+		// the resource was PUT directly rather than deployed, so let's get an updated status from the resource
+		return atc.updateFromExistingResource(ctx, res)
 	default:
 		// no provisioning state and no deployment ID, so we need to start a new deployment
 		return atc.startNewDeploy(ctx, res)
@@ -190,7 +200,28 @@ func (atc *AzureTemplateClient) updateFromExistingDeployment(ctx context.Context
 	return atc.cleanupDeployment(ctx, res)
 }
 
+// updateFromExistingResource reads the provisioning state of a resource which was PUT directly
+func (atc *AzureTemplateClient) updateFromExistingResource(ctx context.Context, res *Resource) (*Resource, error) {
+	latest, err := atc.GetResource(ctx, &Resource{
+		ID:         res.ID,
+		APIVersion: res.APIVersion,
+	})
+	if err != nil {
+		return res, err
+	}
+
+	res.ETag = latest.ETag
+	res.Properties = latest.Properties
+	res.ProvisioningState = propertiesProvisioningState(latest.Properties)
+	return res, nil
+}
+
 func (atc *AzureTemplateClient) startNewDeploy(ctx context.Context, res *Resource) (*Resource, error) {
+	if res.ID != "" && res.ETag != "" {
+		// deployments do not accept an If-Match header, so a resource which tracks ETags is PUT directly instead
+		return atc.putResource(ctx, res)
+	}
+
 	// no status yet, so start provisioning
 	deploymentUUID, err := uuid.NewUUID()
 	if err != nil {
@@ -237,6 +268,43 @@ func (atc *AzureTemplateClient) startNewDeploy(ctx context.Context, res *Resourc
 	return atc.cleanupDeployment(ctx, res)
 }
 
+// putResource PUTs the resource directly with its last known ETag as If-Match, so Azure rejects the request with a
+// PreconditionFailedError if the resource was changed since it was last read, rather than the changes being
+// overwritten
+func (atc *AzureTemplateClient) putResource(ctx context.Context, res *Resource) (*Resource, error) {
+	body := struct {
+		Location   string            `json:"location,omitempty"`
+		Kind       string            `json:"kind,omitempty"`
+		Tags       map[string]string `json:"tags,omitempty"`
+		ManagedBy  string            `json:"managedBy,omitempty"`
+		SKU        *SKU              `json:"sku,omitempty"`
+		Zones      []string          `json:"zones,omitempty"`
+		Properties json.RawMessage   `json:"properties,omitempty"`
+	}{
+		Location:   res.Location,
+		Kind:       res.Kind,
+		Tags:       res.Tags,
+		ManagedBy:  res.ManagedBy,
+		SKU:        res.SKU,
+		Zones:      res.Zones,
+		Properties: res.Properties,
+	}
+
+	path := fmt.Sprintf("%s?api-version=%s", res.ID, res.APIVersion)
+	var etag string
+	var applied json.RawMessage
+	if err := atc.RawClient.PutResource(ctx, path, body, &applied, withIfMatch(res.ETag), withResponseETag(&etag)); err != nil {
+		return res, fmt.Errorf("apply failed with: %w", err)
+	}
+
+	if err := fillResourceFromBody(applied, etag, res); err != nil {
+		return res, err
+	}
+
+	res.ProvisioningState = propertiesProvisioningState(res.Properties)
+	return res, nil
+}
+
 func (atc *AzureTemplateClient) DeleteApply(ctx context.Context, deploymentID string) error {
 	return atc.RawClient.DeleteResource(ctx, idWithAPIVersion(deploymentID), nil)
 }
@@ -275,7 +343,7 @@ func (atc *AzureTemplateClient) BeginDelete(ctx context.Context, res *Resource)
 	}
 
 	path := fmt.Sprintf("%s?api-version=%s", res.ID, res.APIVersion)
-	if err := atc.RawClient.DeleteResource(ctx, path, &res); err != nil {
+	if err := atc.RawClient.DeleteResource(ctx, path, &res, withIfMatch(res.ETag)); err != nil {
 		return res, fmt.Errorf("failed deleting %s with %w and error type %T", res.Type, err, err)
 	}
 
@@ -304,6 +372,21 @@ func (atc *AzureTemplateClient) HeadResource(ctx context.Context, res *Resource)
 	}
 }
 
+// InvokeAction POSTs to an action of the resource, such as listKeys, and returns the body of the response
+func (atc *AzureTemplateClient) InvokeAction(ctx context.Context, res *Resource, action string) (json.RawMessage, error) {
+	if res.ID == "" {
+		return nil, fmt.Errorf("resource ID cannot be empty")
+	}
+
+	path := fmt.Sprintf("%s/%s?api-version=%s", res.ID, action, res.APIVersion)
+	var body json.RawMessage
+	if err := atc.RawClient.PostResource(ctx, path, &body); err != nil {
+		return nil, fmt.Errorf("failed invoking %s on %s with: %w", action, res.Type, err)
+	}
+
+	return body, nil
+}
+
 func fillResource(de *Deployment, res *Resource) error {
 	res.DeploymentID = de.ID
 	if de.Properties != nil {
@@ -323,6 +406,7 @@ func fillResource(de *Deployment, res *Resource) error {
 
 		tOutValue := templateOutput.Value
 		res.SubscriptionID = tOutValue.SubscriptionID
+		res.ETag = tOutValue.ETag
 		res.Properties = tOutValue.Properties
 
 		if de.Properties.OutputResources != nil && len(de.Properties.OutputResources) == 1 && de.Properties.OutputResources[0].ID != "" {
@@ -335,6 +419,46 @@ func fillResource(de *Deployment, res *Resource) error {
 	return nil
 }
 
+// fillResourceFromBody fills the resource with the body of a response from Azure, along with the ETag from the header
+// of the response
+func fillResourceFromBody(body json.RawMessage, etag string, res *Resource) error {
+	if len(body) == 0 {
+		res.ETag = etag
+		return nil
+	}
+
+	if err := json.Unmarshal(body, res); err != nil {
+		return err
+	}
+
+	// not all resource providers return the ETag header, but the ones which track ETags include it in the body
+	if etag == "" {
+		var etagged struct {
+			ETag string `json:"etag,omitempty"`
+		}
+		if err := json.Unmarshal(body, &etagged); err != nil {
+			return err
+		}
+		etag = etagged.ETag
+	}
+
+	res.ETag = etag
+	return nil
+}
+
+// propertiesProvisioningState returns the provisioning state held by the properties of a resource. Resources which do
+// not report one are provisioned as soon as they are written, so they are Succeeded.
+func propertiesProvisioningState(properties json.RawMessage) ProvisioningState {
+	var provisioned struct {
+		ProvisioningState ProvisioningState `json:"provisioningState,omitempty"`
+	}
+	if len(properties) == 0 || json.Unmarshal(properties, &provisioned) != nil || provisioned.ProvisioningState == "" {
+		return SucceededProvisioningState
+	}
+
+	return provisioned.ProvisioningState
+}
+
 // GetSettingsFromEnvironment returns the available authentication settings from the environment.
 func GetSettingsFromEnvironment(env Enver) (s auth.EnvironmentSettings, err error) {
 	s = auth.EnvironmentSettings{
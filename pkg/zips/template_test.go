@@ -6,9 +6,14 @@ Licensed under the MIT license.
 package zips_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/onsi/gomega"
 
 	"github.com/stretchr/testify/mock"
@@ -83,3 +88,138 @@ func TestNewAzureTemplateClient(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureTemplateClient_GetResource_TracksETag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `W/"etag2"`)
+		_, _ = w.Write([]byte(`{"id":"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet","etag":"W/\"etag1\""}`))
+	}))
+	defer server.Close()
+
+	atc := newTestTemplateClient(server)
+	res, err := atc.GetResource(context.Background(), &zips.Resource{
+		ID:         "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+		APIVersion: "2019-11-01",
+	})
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(res.ETag).To(gomega.Equal(`W/"etag2"`))
+}
+
+func TestAzureTemplateClient_BeginDelete_PreconditionFailed(t *testing.T) {
+	var ifMatch string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch = r.Header.Get("If-Match")
+		w.WriteHeader(http.StatusPreconditionFailed)
+	}))
+	defer server.Close()
+
+	atc := newTestTemplateClient(server)
+	_, err := atc.BeginDelete(context.Background(), &zips.Resource{
+		ID:         "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+		APIVersion: "2019-11-01",
+		ETag:       `W/"etag1"`,
+	})
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(ifMatch).To(gomega.Equal(`W/"etag1"`))
+	g.Expect(zips.IsPreconditionFailed(err)).To(gomega.BeTrue())
+}
+
+func TestAzureTemplateClient_InvokeAction(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.RequestURI()
+		_, _ = w.Write([]byte(`{"keys":[{"keyName":"key1","value":"secret"}]}`))
+	}))
+	defer server.Close()
+
+	atc := newTestTemplateClient(server)
+	body, err := atc.InvokeAction(context.Background(), &zips.Resource{
+		ID:         "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa",
+		APIVersion: "2019-06-01",
+	}, "listKeys")
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(method).To(gomega.Equal(http.MethodPost))
+	g.Expect(path).To(gomega.Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa/listKeys?api-version=2019-06-01"))
+	g.Expect(string(body)).To(gomega.ContainSubstring(`"value":"secret"`))
+}
+
+func TestAzureTemplateClient_Apply_PreconditionFailed(t *testing.T) {
+	var method, ifMatch string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, ifMatch = r.Method, r.Header.Get("If-Match")
+		w.WriteHeader(http.StatusPreconditionFailed)
+	}))
+	defer server.Close()
+
+	atc := newTestTemplateClient(server)
+	_, err := atc.Apply(context.Background(), &zips.Resource{
+		ID:            "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+		Name:          "vnet",
+		Type:          "Microsoft.Network/virtualNetworks",
+		ResourceGroup: "rg",
+		APIVersion:    "2019-11-01",
+		ETag:          `W/"etag1"`,
+	})
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(method).To(gomega.Equal(http.MethodPut))
+	g.Expect(ifMatch).To(gomega.Equal(`W/"etag1"`))
+	g.Expect(zips.IsPreconditionFailed(err)).To(gomega.BeTrue())
+}
+
+func TestAzureTemplateClient_Apply_PutsResourceWithETag(t *testing.T) {
+	var path string
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.RequestURI()
+		if r.Method == http.MethodPut {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = w.Write([]byte(`{"etag":"W/\"etag2\"","properties":{"provisioningState":"Updating"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"etag":"W/\"etag3\"","properties":{"provisioningState":"Succeeded"}}`))
+	}))
+	defer server.Close()
+
+	atc := newTestTemplateClient(server)
+	res, err := atc.Apply(context.Background(), &zips.Resource{
+		ID:            "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet",
+		Name:          "vnet",
+		Type:          "Microsoft.Network/virtualNetworks",
+		ResourceGroup: "rg",
+		Location:      "westus2",
+		APIVersion:    "2019-11-01",
+		ETag:          `W/"etag1"`,
+		Properties:    []byte(`{"enableVmProtection":true}`),
+	})
+
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(path).To(gomega.Equal("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet?api-version=2019-11-01"))
+	g.Expect(body).To(gomega.HaveKeyWithValue("location", "westus2"))
+	g.Expect(body).To(gomega.HaveKeyWithValue("properties", map[string]interface{}{"enableVmProtection": true}))
+	g.Expect(body).ToNot(gomega.HaveKey("apiVersion"))
+	g.Expect(res.ProvisioningState).To(gomega.Equal(zips.ProvisioningState("Updating")))
+	g.Expect(res.DeploymentID).To(gomega.BeEmpty())
+
+	// the resource was not deployed, so its provisioning state is read from the resource
+	res, err = atc.Apply(context.Background(), res)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(res.ProvisioningState).To(gomega.Equal(zips.SucceededProvisioningState))
+	g.Expect(res.ETag).To(gomega.Equal(`W/"etag3"`))
+}
+
+func newTestTemplateClient(server *httptest.Server) *zips.AzureTemplateClient {
+	return &zips.AzureTemplateClient{
+		RawClient: &zips.Client{
+			Authorizer: autorest.NullAuthorizer{},
+			Host:       server.URL + "/",
+		},
+		SubscriptionID: "sub",
+	}
+}
@@ -20,10 +20,12 @@ type (
 		BeginDelete(ctx context.Context, res *Resource) (*Resource, error)
 		GetResource(ctx context.Context, res *Resource) (*Resource, error)
 		HeadResource(ctx context.Context, res *Resource) (bool, error)
+		InvokeAction(ctx context.Context, res *Resource, action string) (json.RawMessage, error)
 	}
 
 	ResourceMeta struct {
 		PreserveDeployment bool
+		RetryOnConflict    bool
 	}
 
 	Resource struct {
@@ -32,22 +34,35 @@ type (
 		SubscriptionID    string            `json:"-"`
 		ProvisioningState ProvisioningState `json:"-"`
 		DeploymentID      string            `json:"-"`
+		ETag              string            `json:"-"`
 		ID                string            `json:"id,omitempty"`
 		Name              string            `json:"name,omitempty"`
 		Location          string            `json:"location,omitempty"`
 		Type              string            `json:"type,omitempty"`
+		Kind              string            `json:"kind,omitempty"`
 		Tags              map[string]string `json:"tags,omitempty"`
 		ManagedBy         string            `json:"managedBy,omitempty"`
 		APIVersion        string            `json:"apiVersion,omitempty"`
+		SKU               *SKU              `json:"sku,omitempty"`
+		Zones             []string          `json:"zones,omitempty"`
 		Properties        json.RawMessage   `json:"properties,omitempty"`
 	}
 
+	// SKU is the pricing tier of a resource, for the resource types which have one
+	SKU struct {
+		Name string `json:"name,omitempty"`
+		Tier string `json:"tier,omitempty"`
+	}
+
 	AnnotationKey string
 )
 
 const (
 	// PreserveDeploymentAnnotation is the key which tells the applier to keep or delete the deployment
 	PreserveDeploymentAnnotation AnnotationKey = "x-preserve-deployment"
+	// RetryOnConflictAnnotation is the key which tells the applier to read the latest ETag and try again when the
+	// resource has been changed outside of the operator, rather than stopping with a conflict
+	RetryOnConflictAnnotation AnnotationKey = "x-retry-on-conflict"
 )
 
 // SetAnnotations will set the metadata fields on the resource with the values derived from the annotations
@@ -55,5 +70,8 @@ func (res *Resource) SetAnnotations(annotations map[string]string) *Resource {
 	if val, ok := annotations[string(PreserveDeploymentAnnotation)]; ok {
 		res.ObjectMeta.PreserveDeployment = strings.ToLower(val) == "true"
 	}
+	if val, ok := annotations[string(RetryOnConflictAnnotation)]; ok {
+		res.ObjectMeta.RetryOnConflict = strings.ToLower(val) == "true"
+	}
 	return res
 }